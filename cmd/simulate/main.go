@@ -0,0 +1,61 @@
+// Command simulate runs the game headless, with no window or audio, using a
+// simple autopilot for the controls. It is handy for soak-testing gameplay
+// changes over many seeds on a build server.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/entity"
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/sim"
+	"github.com/rm-hull/asteroids/internal/sound"
+	"github.com/rm-hull/asteroids/internal/theme"
+)
+
+var screenSize = geometry.Dimension{W: 1024, H: 768}
+
+// autopilot spins on the spot spraying bullets, thrusting every so often to
+// avoid sitting still.
+func autopilot(tick int) input.Actions {
+	actions := input.Actions(0).With(input.RotateRight).With(input.Fire)
+	if tick%240 < 20 {
+		actions = actions.With(input.Thrust)
+	}
+	return actions
+}
+
+func main() {
+	seed := flag.Int64("seed", 1, "random seed of the first game")
+	games := flag.Int("games", 1, "number of games to play, with consecutive seeds")
+	maxDuration := flag.Duration("max-duration", 30*time.Minute, "give up on a game after this much game time")
+	themeName := flag.String("theme", theme.Default, "built-in sprite sheet to take collision shapes from")
+	flag.Parse()
+
+	if err := theme.NewRegistry().Use(*themeName); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	maxTicks := internal.Ticks(*maxDuration)
+	world := sim.NewWorld(&screenSize, sound.Mute{}, entity.DefaultRules, *seed)
+
+	fmt.Println("seed\tticks\tlevel\tscore")
+	for i := 0; i < *games; i++ {
+		world.Reset(*seed + int64(i))
+
+		for !world.IsGameOver() && world.Ticks < maxTicks {
+			if err := world.Step(autopilot(world.Ticks)); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+
+		fmt.Printf("%d\t%d\t%d\t%d\n", world.Seed, world.Ticks, world.Level.Current(), world.Player.Score().Total())
+	}
+}
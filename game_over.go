@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/fonts"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/sound"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type GameOverScene struct {
+	game  *Game
+	timer *internal.Timer
+}
+
+func NewGameOverScene(game *Game) *GameOverScene {
+	return &GameOverScene{
+		game:  game,
+		timer: internal.NewTimer(5 * time.Second),
+	}
+}
+
+func (s *GameOverScene) Enter() {
+	s.timer.Reset()
+	s.game.Sounds.Play(sound.GameOver)
+	s.game.SaveReplay()
+}
+
+func (s *GameOverScene) Update() error {
+	s.timer.Update()
+
+	switch {
+	case s.game.Controls.IsJustPressed(input.Restart):
+		s.moveOn(func() {
+			s.game.Reset()
+			s.game.Scenes.SwitchTo(NewPlayingScene(s.game))
+		})
+	case s.timer.IsReady() || s.game.Controls.IsJustPressed(input.Start):
+		s.moveOn(func() {
+			s.game.Scenes.SwitchTo(NewTitleScene(s.game))
+		})
+	default:
+		return s.game.World.Drift()
+	}
+	return nil
+}
+
+// moveOn leaves the game over screen for next, by way of entering initials
+// if the score made the high score table, so that it isn't lost by
+// restarting straight away.
+func (s *GameOverScene) moveOn(next func()) {
+	score := s.game.World.Player.Score().Total()
+	level := s.game.World.Level.Current()
+	if !s.game.HighScores.Qualifies(score) {
+		next()
+		return
+	}
+
+	s.game.Scenes.SwitchTo(NewInitialsScene(s.game, func(initials string) {
+		s.game.RecordHighScore(initials, score, level)
+		next()
+	}))
+}
+
+func (s *GameOverScene) Draw(screen *ebiten.Image) {
+	s.game.Renderer.World(screen, s.game.World, s.game.Blend())
+	drawCentred(screen, "GAME OVER", fonts.AsteroidsFace64, 0)
+	drawCentred(screen, "PRESS RESTART TO PLAY AGAIN", fonts.AsteroidsFace32, 96)
+	drawCentred(screen, fmt.Sprintf("SEED: %d", s.game.World.Seed), fonts.AsteroidsFace32, 320)
+}
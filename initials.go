@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rm-hull/asteroids/internal/fonts"
+	"github.com/rm-hull/asteroids/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	alphabet       = "ABCDEFGHIJKLMNOPQRSTUVWXYZ "
+	numInitials    = 3
+	cursorBlinkLen = 20
+)
+
+// InitialsScene is the arcade-style letter picker: rotate through the
+// alphabet for each slot in turn, and fire to lock the letter in. Once all
+// of them are in, complete is called with the initials, and moves on to
+// whichever scene is next.
+type InitialsScene struct {
+	game     *Game
+	letters  [numInitials]int
+	slot     int
+	ticks    int
+	complete func(initials string)
+}
+
+func NewInitialsScene(game *Game, complete func(initials string)) *InitialsScene {
+	return &InitialsScene{
+		game:     game,
+		complete: complete,
+	}
+}
+
+func (s *InitialsScene) Update() error {
+	s.ticks++
+
+	controls := s.game.Controls
+	switch {
+	case controls.IsJustPressed(input.RotateLeft), controls.IsJustPressed(input.Hyperspace):
+		s.letters[s.slot] = (s.letters[s.slot] + len(alphabet) - 1) % len(alphabet)
+	case controls.IsJustPressed(input.RotateRight), controls.IsJustPressed(input.Thrust):
+		s.letters[s.slot] = (s.letters[s.slot] + 1) % len(alphabet)
+	case controls.IsJustPressed(input.Fire), controls.IsJustPressed(input.Start):
+		s.slot++
+		if s.slot == numInitials {
+			s.complete(s.Initials())
+			return nil
+		}
+	}
+
+	return s.game.World.Drift()
+}
+
+func (s *InitialsScene) Initials() string {
+	initials := make([]byte, numInitials)
+	for i, letter := range s.letters {
+		initials[i] = alphabet[letter]
+	}
+	return string(initials)
+}
+
+func (s *InitialsScene) Draw(screen *ebiten.Image) {
+	s.game.Renderer.Asteroids(screen, s.game.World, s.game.Blend())
+
+	drawCentred(screen, fmt.Sprintf("YOUR SCORE IS %d", s.game.World.Player.Score().Total()), fonts.AsteroidsFace32, -160)
+	drawCentred(screen, "PLEASE ENTER YOUR INITIALS", fonts.AsteroidsFace32, -112)
+	drawCentred(screen, "ROTATE TO SELECT LETTER", fonts.AsteroidsFace32, -64)
+	drawCentred(screen, "FIRE TO CONFIRM", fonts.AsteroidsFace32, -32)
+
+	initials := []byte(s.Initials())
+	for i := range initials {
+		if initials[i] == ' ' {
+			initials[i] = '_'
+		}
+	}
+	if s.slot < numInitials && (s.ticks/cursorBlinkLen)%2 == 1 {
+		initials[s.slot] = ' '
+	}
+	drawCentred(screen, string(initials), fonts.AsteroidsFace64, 64)
+}
@@ -0,0 +1,41 @@
+package internal
+
+import "time"
+
+// Bound how far the simulation will try to catch up in one go, e.g. after
+// the window has been dragged or the machine has been suspended, rather than
+// fast-forwarding through all the missed time.
+const maxStepsPerAdvance = 8
+
+// Clock converts elapsed real time into a whole number of fixed simulation
+// ticks, carrying the remainder over so that nothing is lost. The remainder,
+// as a fraction of a tick, is how far the display is between the last two
+// ticks and is used to interpolate positions when drawing.
+type Clock struct {
+	delta       time.Duration
+	accumulator time.Duration
+}
+
+func NewClock() *Clock {
+	return &Clock{
+		delta: time.Second / TicksPerSecond,
+	}
+}
+
+// Advance returns the number of ticks to step the simulation by.
+func (c *Clock) Advance(elapsed time.Duration) int {
+	c.accumulator += elapsed
+
+	steps := int(c.accumulator / c.delta)
+	c.accumulator -= time.Duration(steps) * c.delta
+
+	if steps > maxStepsPerAdvance {
+		steps = maxStepsPerAdvance
+	}
+	return steps
+}
+
+// Blend is the fraction (0..1) of the way through the next tick.
+func (c *Clock) Blend() float64 {
+	return float64(c.accumulator) / float64(c.delta)
+}
@@ -1,64 +1,101 @@
 package entity
 
 import (
+	"maps"
 	"math"
 	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/rm-hull/asteroids/internal"
 	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sound"
 	"github.com/rm-hull/asteroids/internal/sprites"
-	"github.com/rm-hull/asteroids/resources/soundfx"
-
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// Alien is the flying saucer. Like the arcade game, it comes in two sizes:
+// the large saucer fires off at random, whereas the small one, which is
+// harder to hit and worth more, aims at the player.
 type Alien struct {
 	sprite           *sprites.Sprite
+	size             int
+	level            int
 	screenBounds     *geometry.Dimension
 	deadTimer        *internal.Timer
 	respawnTimer     *internal.Timer
 	shootCooldown    *internal.Timer
 	bullets          map[int]*Bullet
 	sequence         *internal.Sequence
-	playerPosition   func() *geometry.Vector
+	player           *Player
+	behaviour        Behaviour
 	shootingAccuracy float64
 	maxSalvo         int
+	env              *Env
 }
 
-const respawnDuration = 30 * time.Second
+const (
+	respawnDuration = 30 * time.Second
+
+	// Small saucers are all there is once the player has this many points.
+	allSmallSaucersScore = 40000
+)
 
-func NewAlien(level int, position *geometry.Vector, playerPosition func() *geometry.Vector, screenBounds *geometry.Dimension) *Alien {
-	sprite := sprites.NewSprite(screenBounds, sprites.AlienSpaceShip, true)
-	sprite.Position = position
+func NewAlien(level int, position *geometry.Vector, player *Player, env *Env) *Alien {
+	screenBounds := env.ScreenBounds
 
-	return &Alien{
-		sprite:           sprite,
+	a := &Alien{
+		sprite:           sprites.NewSprite(screenBounds, sprites.AlienSpaceShip, true),
+		level:            level,
 		screenBounds:     screenBounds,
 		respawnTimer:     internal.NewTimer(respawnDuration),
 		shootCooldown:    internal.NewTimer(5 * time.Second),
 		sequence:         internal.NewSequence(),
 		bullets:          make(map[int]*Bullet),
-		playerPosition:   playerPosition,
-		shootingAccuracy: 0.8,
+		player:           player,
+		shootingAccuracy: alienAccuracy(level),
 		maxSalvo:         3 + level,
+		env:              env,
 	}
+	a.sprite.Position = position
+	a.chooseSize()
+	return a
 }
 
-func (a *Alien) Draw(screen *ebiten.Image) {
-	for _, bullet := range a.bullets {
-		bullet.Draw(screen)
+// chooseSize decides which saucer turns up next, and how it behaves: the
+// small one becomes more likely with each level, and as the player's score
+// goes up.
+func (a *Alien) chooseSize() {
+	chance := 0.1*float64(a.level-1) + float64(a.player.Score().Total())/allSmallSaucersScore
+
+	a.size = sprites.Large
+	if a.env.Rand.Float64() < chance {
+		a.size = sprites.Small
 	}
 
-	if a.respawnTimer.IsReady() {
+	position := a.sprite.Position
+	a.sprite = sprites.NewSprite(a.screenBounds, sprites.Alien(a.size), true)
+	a.sprite.Position = position
+	a.behaviour = behaviourFor(a.size, a.level, a.env.Rand)
+}
 
-		if a.IsDying() {
-			fade := 1.0 - a.deadTimer.PercentComplete()
-			a.sprite.ColorModel.Scale(1.0, 1.0, 1.0, fade)
-		}
+func (a *Alien) IsSmall() bool {
+	return a.size == sprites.Small
+}
+
+func (a *Alien) Sprite() *sprites.Sprite {
+	return a.sprite
+}
 
-		a.sprite.Draw(screen)
+func (a *Alien) IsVisible() bool {
+	return a.respawnTimer.IsReady()
+}
+
+// Alpha is zero once the alien has been hit, as it has broken up.
+func (a *Alien) Alpha() float64 {
+	if a.IsDying() {
+		return 0.0
 	}
+	return 1.0
 }
 
 func (a *Alien) Update() error {
@@ -90,45 +127,71 @@ func (a *Alien) Update() error {
 }
 
 func (a *Alien) HandleMovement() {
-	delta := (rand.Float64() - 0.5) * 0.6
-	a.sprite.Direction += delta
+	a.behaviour.Steer(a)
+}
 
-	thrusting := rand.Float64() > 0.3
-	if thrusting {
-		a.sprite.MoveForward(0.3, maxSpeed)
-	}
+// steerTowards turns the saucer's heading, no faster than it can turn,
+// towards direction, and sets it off at the given speed.
+func (a *Alien) steerTowards(direction float64, speed float64) {
+	delta := math.Remainder(direction-a.sprite.Direction, 2*math.Pi)
+	a.sprite.Direction += math.Max(-saucerTurnRate, math.Min(saucerTurnRate, delta))
+	a.sprite.Velocity = geometry.VectorFrom(a.sprite.Direction, speed)
+}
+
+// bearingToPlayer is the direction the player is in, going the short way
+// round the screen.
+func (a *Alien) bearingToPlayer() float64 {
+	offset := toroidalOffset(a.Position(), a.player.Position(), a.screenBounds)
+	return math.Atan2(offset.Y, offset.X)
 }
 
-func randomDuration(min, max time.Duration) time.Duration {
+func (a *Alien) distanceToPlayer() float64 {
+	return a.Position().ToroidalDistanceFrom(a.player.Position(), a.screenBounds)
+}
+
+func randomDuration(rng *rand.Rand, min, max time.Duration) time.Duration {
 	if min > max {
 		min, max = max, min
 	}
 
-	return time.Duration(rand.Int63n(int64(max-min))) + min
+	return time.Duration(rng.Int63n(int64(max-min))) + min
 }
 
 func (a *Alien) HandleShooting() {
 	a.shootCooldown.Update()
 	if a.shootCooldown.IsReady() && len(a.bullets) < a.maxSalvo {
-		duration := randomDuration(1*time.Second, 8*time.Second)
+		duration := randomDuration(a.env.Rand, 1*time.Second, 8*time.Second)
 		a.shootCooldown.ResetTarget(duration)
 
-		direction := a.sprite.Position.AngleTo(a.playerPosition()) + a.ShootingJitter()
-		spawnPosn := geometry.Add(a.Position(), geometry.VectorFrom(direction, 60))
-		a.bullets[a.sequence.GetNext()] = NewBullet(a.screenBounds, spawnPosn, direction, sprites.Large)
-
-		sfxPlayer := audioContext.NewPlayerFromBytes(soundfx.LazerGunShot2)
-		sfxPlayer.SetVolume(0.5)
-		sfxPlayer.Play()
+		// Its bullets wrap, so that shots aimed through an edge get there.
+		direction := a.behaviour.Aim(a)
+		spawnPosn := geometry.Add(a.Position(), geometry.VectorFrom(direction, alienMuzzle))
+		a.bullets[a.sequence.GetNext()] = NewBullet(a.screenBounds, spawnPosn, direction, sprites.Large, true)
+		a.env.Sounds.Play(sound.AlienFire)
 	}
 }
 
+func (a *Alien) aimAtPlayer() float64 {
+	return a.leadShot() + a.ShootingJitter()
+}
+
 func (a *Alien) ShootingJitter() float64 {
-	return (rand.Float64() - 0.5) * (1 - a.shootingAccuracy)
+	return (a.env.Rand.Float64() - 0.5) * (1 - a.shootingAccuracy)
 }
 
 func (a *Alien) Value() int {
-	return 1000
+	if a.IsSmall() {
+		return 1000
+	}
+	return 200
+}
+
+func (a *Alien) Hull() []geometry.Vector {
+	return a.sprite.Hull()
+}
+
+func (a *Alien) Reach() float64 {
+	return a.sprite.Frame.Reach
 }
 
 func (a *Alien) Position() *geometry.Vector {
@@ -136,15 +199,13 @@ func (a *Alien) Position() *geometry.Vector {
 }
 
 func (a *Alien) Size() float64 {
-	return a.sprite.Centre.Y * 0.75
+	return a.sprite.Frame.Radius
 }
 
 func (a *Alien) Kill() {
 	a.deadTimer = internal.NewTimer(deathDuration)
-
-	sePlayer := audioContext.NewPlayerFromBytes(soundfx.Explosion2)
-	sePlayer.SetVolume(0.15)
-	sePlayer.Play()
+	a.env.Sounds.Play(sound.AlienExplosion)
+	a.env.Particles.ShipExplosion(*a.Position(), *a.sprite.Velocity, a.Hull())
 }
 
 func (a *Alien) IsAlive() bool {
@@ -152,8 +213,8 @@ func (a *Alien) IsAlive() bool {
 }
 
 func (a *Alien) Bullets(callback func(bullet *Bullet)) {
-	for _, bullet := range a.bullets {
-		callback(bullet)
+	for _, idx := range slices.Sorted(maps.Keys(a.bullets)) {
+		callback(a.bullets[idx])
 	}
 }
 
@@ -162,12 +223,13 @@ func (a *Alien) IsDying() bool {
 }
 
 func (a *Alien) SpinOutOfControl() {
-	a.sprite.Orientation += 3 * math.Pi / float64(ebiten.TPS())
+	a.sprite.Orientation += 3 * math.Pi / internal.TicksPerSecond
 	a.deadTimer.Update()
 
 	if a.deadTimer.IsReady() {
 		a.respawnTimer.Reset()
 		a.sprite.Reset()
 		a.deadTimer = nil
+		a.chooseSize()
 	}
 }
@@ -3,13 +3,11 @@ package entity
 import (
 	"github.com/rm-hull/asteroids/internal"
 	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sound"
 	"github.com/rm-hull/asteroids/internal/sprites"
-	"github.com/rm-hull/asteroids/resources/soundfx"
 
 	"math"
 	"math/rand"
-
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
 const asteroidMaxSpeed = 2
@@ -18,11 +16,13 @@ type Asteroid struct {
 	sprite       *sprites.Sprite
 	size         int
 	exploded     bool
+	smashed      bool
 	screenBounds *geometry.Dimension
+	env          *Env
 }
 
-func randSize() int {
-	n := rand.Intn(10)
+func randSize(rng *rand.Rand) int {
+	n := rng.Intn(10)
 	if n < 5 {
 		return sprites.Large
 	}
@@ -34,37 +34,36 @@ func randSize() int {
 	return sprites.Small
 }
 
-func NewAsteroidBelt(n int, seq *internal.Sequence, player *Player, screenBounds *geometry.Dimension) map[int]*Asteroid {
+func NewAsteroidBelt(n int, seq *internal.Sequence, player *Player, env *Env) map[int]*Asteroid {
 	var asteroids = make(map[int]*Asteroid)
 	for i := 0; i < n; i++ {
 		idx := seq.GetNext()
-		asteroids[idx] = NewAsteroid(randSize(), player.NotNear(), screenBounds)
+		asteroids[idx] = NewAsteroid(randSize(env.Rand), player.NotNear(), env)
 	}
 	return asteroids
 }
 
-func NewAsteroid(size int, position *geometry.Vector, screenBounds *geometry.Dimension) *Asteroid {
-
-	sprite := sprites.NewSprite(screenBounds, sprites.Asteroid(size), true)
-	sprite.Speed = (rand.Float64() + 0.3) * asteroidMaxSpeed
-	sprite.Direction = rand.Float64() * 2 * math.Pi
+func NewAsteroid(size int, position *geometry.Vector, env *Env) *Asteroid {
+	rng := env.Rand
+	sprite := sprites.NewSprite(env.ScreenBounds, sprites.Asteroid(size, rng), true)
+	sprite.Speed = (rng.Float64() + 0.3) * asteroidMaxSpeed
+	sprite.Direction = rng.Float64() * 2 * math.Pi
 	sprite.Position.X = position.X
 	sprite.Position.Y = position.Y
 	sprite.Velocity = geometry.VectorFrom(sprite.Direction, sprite.Speed)
-	sprite.Rotation = (rand.Float64() - 0.5) / 20
+	sprite.Rotation = (rng.Float64() - 0.5) / 20
 
 	return &Asteroid{
 		sprite:       sprite,
 		size:         size,
 		exploded:     false,
-		screenBounds: screenBounds,
+		screenBounds: env.ScreenBounds,
+		env:          env,
 	}
 }
 
-func (a *Asteroid) Draw(screen *ebiten.Image) {
-	if !a.exploded {
-		a.sprite.Draw(screen)
-	}
+func (a *Asteroid) Sprite() *sprites.Sprite {
+	return a.sprite
 }
 
 func (a *Asteroid) Update() error {
@@ -78,25 +77,25 @@ func (a *Asteroid) Update() error {
 
 func (a *Asteroid) Explode() []*Asteroid {
 	a.exploded = true
-	sePlayer := audioContext.NewPlayerFromBytes(soundfx.Explosion2)
-	sePlayer.SetVolume(0.15)
-	sePlayer.Play()
+	a.env.Sounds.Play(sound.AsteroidExplosion)
+	a.env.Particles.AsteroidDebris(*a.Position(), *a.sprite.Velocity, a.Size())
+	rng := a.env.Rand
 
 	arr := make([]*Asteroid, 0)
 	switch a.size {
 	case sprites.Large:
-		n := rand.Intn(3) + 1
+		n := rng.Intn(3) + 1
 		for i := 0; i < n; i++ {
-			arr = append(arr, NewAsteroid(sprites.Medium, a.sprite.Position, a.screenBounds))
+			arr = append(arr, NewAsteroid(sprites.Medium, a.sprite.Position, a.env))
 		}
-		n = rand.Intn(5 - n)
+		n = rng.Intn(5 - n)
 		for i := 0; i < n; i++ {
-			arr = append(arr, NewAsteroid(sprites.Small, a.sprite.Position, a.screenBounds))
+			arr = append(arr, NewAsteroid(sprites.Small, a.sprite.Position, a.env))
 		}
 	case sprites.Medium:
-		n := rand.Intn(2) + 2
+		n := rng.Intn(2) + 2
 		for i := 0; i < n; i++ {
-			arr = append(arr, NewAsteroid(sprites.Small, a.sprite.Position, a.screenBounds))
+			arr = append(arr, NewAsteroid(sprites.Small, a.sprite.Position, a.env))
 		}
 	default:
 		break
@@ -108,6 +107,17 @@ func (a *Asteroid) IsExploded() bool {
 	return a.exploded
 }
 
+// Smash explodes the asteroid without the player earning anything for it,
+// for when the saucer or one of its bullets runs into it.
+func (a *Asteroid) Smash() []*Asteroid {
+	a.smashed = true
+	return a.Explode()
+}
+
+func (a *Asteroid) IsSmashed() bool {
+	return a.smashed
+}
+
 func (a *Asteroid) Value() int {
 	switch a.size {
 	case sprites.Large:
@@ -122,7 +132,15 @@ func (a *Asteroid) Value() int {
 }
 
 func (a *Asteroid) Size() float64 {
-	return a.sprite.Centre.Y * 0.70
+	return a.sprite.Frame.Radius
+}
+
+func (a *Asteroid) Hull() []geometry.Vector {
+	return a.sprite.Hull()
+}
+
+func (a *Asteroid) Reach() float64 {
+	return a.sprite.Frame.Reach
 }
 
 func (a *Asteroid) Position() *geometry.Vector {
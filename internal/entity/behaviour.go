@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sprites"
+)
+
+// Behaviour is how a saucer flies, and where it shoots.
+type Behaviour interface {
+	// Steer adjusts the saucer's course, once every tick.
+	Steer(a *Alien)
+	// Aim picks the direction of the next shot.
+	Aim(a *Alien) float64
+}
+
+const (
+	saucerSpeed    = 2.0
+	saucerTurnRate = math.Pi / internal.TicksPerSecond
+	// How far away the strafing and evading saucers like to keep.
+	standOffDistance = 250.0
+)
+
+// behaviourFor picks how a saucer flies: the large one always patrols like
+// the original, whereas the small one gets craftier as the levels go by.
+func behaviourFor(size int, level int, rng *rand.Rand) Behaviour {
+	if size == sprites.Large || level < 3 {
+		return newPatrol(rng)
+	}
+
+	switch rng.Intn(3) {
+	case 0:
+		return &Pursue{}
+	case 1:
+		return &Evade{}
+	default:
+		return &Strafe{clockwise: rng.Intn(2) == 0}
+	}
+}
+
+// Patrol crosses the screen from side to side, every so often veering off
+// diagonally for a while. The large saucer fires at random, but the small
+// one aims at the player.
+type Patrol struct {
+	course float64
+	veer   float64
+	timer  *internal.Timer
+	rng    *rand.Rand
+}
+
+func newPatrol(rng *rand.Rand) *Patrol {
+	course := 0.0
+	if rng.Intn(2) == 0 {
+		course = math.Pi
+	}
+	return &Patrol{
+		course: course,
+		timer:  internal.NewTimer(randomDuration(rng, 1*time.Second, 3*time.Second)),
+		rng:    rng,
+	}
+}
+
+func (p *Patrol) Steer(a *Alien) {
+	p.timer.Update()
+	if p.timer.IsReady() {
+		p.timer.ResetTarget(randomDuration(p.rng, 1*time.Second, 3*time.Second))
+		p.veer = float64(p.rng.Intn(3)-1) * math.Pi / 4
+	}
+	a.sprite.Direction = p.course + p.veer
+	a.sprite.Velocity = geometry.VectorFrom(a.sprite.Direction, saucerSpeed)
+}
+
+func (p *Patrol) Aim(a *Alien) float64 {
+	if a.IsSmall() {
+		return a.aimAtPlayer()
+	}
+	return p.rng.Float64() * 2 * math.Pi
+}
+
+// Pursue heads straight for the player.
+type Pursue struct{}
+
+func (p *Pursue) Steer(a *Alien) {
+	a.steerTowards(a.bearingToPlayer(), saucerSpeed*1.25)
+}
+
+func (p *Pursue) Aim(a *Alien) float64 {
+	return a.aimAtPlayer()
+}
+
+// Evade keeps out of the player's way, sniping from a distance.
+type Evade struct{}
+
+func (e *Evade) Steer(a *Alien) {
+	if a.distanceToPlayer() < standOffDistance {
+		a.steerTowards(a.bearingToPlayer()+math.Pi, saucerSpeed*1.5)
+	} else {
+		a.steerTowards(a.sprite.Direction, saucerSpeed/2)
+	}
+}
+
+func (e *Evade) Aim(a *Alien) float64 {
+	return a.aimAtPlayer()
+}
+
+// Strafe circles around the player at a distance, firing inwards.
+type Strafe struct {
+	clockwise bool
+}
+
+func (s *Strafe) Steer(a *Alien) {
+	tangent := math.Pi / 2
+	if !s.clockwise {
+		tangent = -tangent
+	}
+
+	// Lean in or out to stay on the circle.
+	correction := (a.distanceToPlayer() - standOffDistance) / standOffDistance
+	correction = math.Max(-1, math.Min(1, correction)) * math.Pi / 4
+	if s.clockwise {
+		correction = -correction
+	}
+
+	a.steerTowards(a.bearingToPlayer()+tangent+correction, saucerSpeed*1.25)
+}
+
+func (s *Strafe) Aim(a *Alien) float64 {
+	return a.aimAtPlayer()
+}
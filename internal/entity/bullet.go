@@ -6,8 +6,11 @@ import (
 	"github.com/rm-hull/asteroids/internal"
 	"github.com/rm-hull/asteroids/internal/geometry"
 	"github.com/rm-hull/asteroids/internal/sprites"
+)
 
-	"github.com/hajimehoshi/ebiten/v2"
+const (
+	bulletSpeed    = 480.0 / internal.TicksPerSecond
+	bulletLifetime = 2 * time.Second
 )
 
 type Bullet struct {
@@ -17,9 +20,10 @@ type Bullet struct {
 	directHit    bool
 }
 
-func NewBullet(screenBounds *geometry.Dimension, position *geometry.Vector, direction float64, size int) *Bullet {
-	bulletSpeed := float64(480 / ebiten.TPS())
-	sprite := sprites.NewSprite(screenBounds, sprites.Bullet(size), false)
+// NewBullet fires a bullet from position. Wraparound bullets carry on across
+// the edges of the screen, rather than flying off it.
+func NewBullet(screenBounds *geometry.Dimension, position *geometry.Vector, direction float64, size int, wraparound bool) *Bullet {
+	sprite := sprites.NewSprite(screenBounds, sprites.Bullet(size), wraparound)
 	sprite.Direction = direction
 	sprite.Position.X = position.X - sprite.Centre.X
 	sprite.Position.Y = position.Y - sprite.Centre.Y
@@ -27,23 +31,22 @@ func NewBullet(screenBounds *geometry.Dimension, position *geometry.Vector, dire
 
 	return &Bullet{
 		sprite:       sprite,
-		timer:        internal.NewTimer(2 * time.Second),
+		timer:        internal.NewTimer(bulletLifetime),
 		screenBounds: screenBounds,
 		directHit:    false,
 	}
 }
 
-func (b *Bullet) Draw(screen *ebiten.Image) {
-	if b.IsExpired() {
-		return
-	}
+func (b *Bullet) Sprite() *sprites.Sprite {
+	return b.sprite
+}
 
+// Bullets fade away over the last quarter of their lifetime.
+func (b *Bullet) Alpha() float64 {
 	if pctComplete := b.timer.PercentComplete(); pctComplete > 0.75 {
-		fade := ((1.0 - pctComplete) / 0.25)
-		b.sprite.ColorModel.Scale(1, 1, 1, fade)
+		return (1.0 - pctComplete) / 0.25
 	}
-
-	b.sprite.Draw(screen)
+	return 1.0
 }
 
 func (b *Bullet) Update() error {
@@ -57,7 +60,19 @@ func (b *Bullet) Update() error {
 }
 
 func (b *Bullet) IsExpired() bool {
-	return b.directHit || b.timer.IsReady()
+	return b.directHit || b.timer.IsReady() || b.isOffScreen()
+}
+
+// isOffScreen is whether a bullet that doesn't wrap has flown off the edge
+// of the screen. Collisions are measured the short way round the screen, so
+// it would otherwise go on hitting things on the far side.
+func (b *Bullet) isOffScreen() bool {
+	if b.sprite.Wraparound() {
+		return false
+	}
+
+	position := b.Position()
+	return position.X < 0 || position.X >= b.screenBounds.W || position.Y < 0 || position.Y >= b.screenBounds.H
 }
 
 func (b *Bullet) Position() *geometry.Vector {
@@ -65,12 +80,12 @@ func (b *Bullet) Position() *geometry.Vector {
 }
 
 func (b *Bullet) Size() float64 {
-	return b.sprite.Centre.X / 2
+	return b.sprite.Frame.Radius
 }
 
 func (b *Bullet) CollisionDetected(collider Collider) bool {
-	if b.timer.PercentComplete() < 90 && !b.directHit {
-		if hit := CollisionDetected(b, collider); hit {
+	if b.timer.PercentComplete() < 90 && !b.IsExpired() {
+		if hit := CollisionDetected(b, collider, b.screenBounds); hit {
 			b.directHit = true
 			return true
 		}
@@ -7,9 +7,73 @@ type Collider interface {
 	Size() float64
 }
 
-func CollisionDetected(a Collider, b Collider) bool {
-	actaulSquareDist := a.Position().SquareDistanceFrom(b.Position())
-	minDist := a.Size() + b.Size()
-	hit := actaulSquareDist < minDist*minDist
-	return hit
+// Shaped colliders have a convex outline (relative to their Position) that
+// is used instead of their Size, when they have one. Reach is how far the
+// outline can extend from their Position, so that things which are nowhere
+// near each other can be ruled out without working out the outline.
+type Shaped interface {
+	Collider
+	Hull() []geometry.Vector
+	Reach() float64
+}
+
+// CollisionDetected checks whether a and b overlap, allowing for them being
+// either side of an edge of the screen, where they are drawn wrapped around.
+// Things are circles of their Size, unless they have a hull.
+func CollisionDetected(a Collider, b Collider, screenBounds *geometry.Dimension) bool {
+	radiusA, radiusB := BoundingRadius(a), BoundingRadius(b)
+
+	actaulSquareDist := a.Position().ToroidalSquareDistanceFrom(b.Position(), screenBounds)
+	minDist := radiusA + radiusB
+	if actaulSquareDist >= minDist*minDist {
+		return false
+	}
+
+	hullA, hullB := hull(a), hull(b)
+	if hullA == nil && hullB == nil {
+		return true
+	}
+
+	// Work relative to a, so that b is wherever it is nearest to a.
+	offset := toroidalOffset(a.Position(), b.Position(), screenBounds)
+	switch {
+	case hullB == nil:
+		return geometry.PolygonCircleOverlap(hullA, offset, radiusB)
+	case hullA == nil:
+		return geometry.PolygonCircleOverlap(geometry.Translate(hullB, offset), geometry.Vector{}, radiusA)
+	default:
+		return geometry.PolygonsOverlap(hullA, geometry.Translate(hullB, offset))
+	}
+}
+
+// BoundingRadius is how far from its Position a collider reaches.
+func BoundingRadius(c Collider) float64 {
+	if shaped, ok := c.(Shaped); ok {
+		return shaped.Reach()
+	}
+	return c.Size()
+}
+
+func hull(c Collider) []geometry.Vector {
+	if shaped, ok := c.(Shaped); ok {
+		return shaped.Hull()
+	}
+	return nil
+}
+
+func toroidalOffset(from, to *geometry.Vector, screenBounds *geometry.Dimension) geometry.Vector {
+	return geometry.Vector{
+		X: nearest(to.X-from.X, screenBounds.W),
+		Y: nearest(to.Y-from.Y, screenBounds.H),
+	}
+}
+
+func nearest(delta float64, size float64) float64 {
+	for delta > size/2 {
+		delta -= size
+	}
+	for delta < -size/2 {
+		delta += size
+	}
+	return delta
 }
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sprites"
+)
+
+var screenBounds = &geometry.Dimension{W: 1024, H: 768}
+
+type circle struct {
+	position geometry.Vector
+	radius   float64
+}
+
+func (c circle) Position() *geometry.Vector {
+	return &c.position
+}
+
+func (c circle) Size() float64 {
+	return c.radius
+}
+
+func TestToroidalOffset(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to geometry.Vector
+		want     geometry.Vector
+	}{
+		{"no wrapping needed", geometry.Vector{X: 100, Y: 100}, geometry.Vector{X: 150, Y: 80}, geometry.Vector{X: 50, Y: -20}},
+		{"across the right edge", geometry.Vector{X: 1014, Y: 300}, geometry.Vector{X: 10, Y: 300}, geometry.Vector{X: 20, Y: 0}},
+		{"across the left edge", geometry.Vector{X: 10, Y: 300}, geometry.Vector{X: 1014, Y: 300}, geometry.Vector{X: -20, Y: 0}},
+		{"across the bottom edge", geometry.Vector{X: 500, Y: 758}, geometry.Vector{X: 500, Y: 10}, geometry.Vector{X: 0, Y: 20}},
+		{"across a corner", geometry.Vector{X: 2, Y: 4}, geometry.Vector{X: 1020, Y: 764}, geometry.Vector{X: -6, Y: -8}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := toroidalOffset(&test.from, &test.to, screenBounds); got != test.want {
+				t.Errorf("offset from %v to %v = %v, want %v", test.from, test.to, got, test.want)
+			}
+		})
+	}
+}
+
+func TestCollisionDetectedAcrossEdges(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b circle
+		want bool
+	}{
+		{"overlapping", circle{geometry.Vector{X: 100, Y: 100}, 10}, circle{geometry.Vector{X: 115, Y: 100}, 10}, true},
+		{"apart", circle{geometry.Vector{X: 100, Y: 100}, 10}, circle{geometry.Vector{X: 125, Y: 100}, 10}, false},
+		{"overlapping across an edge", circle{geometry.Vector{X: 5, Y: 300}, 10}, circle{geometry.Vector{X: 1019, Y: 300}, 10}, true},
+		{"apart across an edge", circle{geometry.Vector{X: 15, Y: 300}, 10}, circle{geometry.Vector{X: 1005, Y: 300}, 10}, false},
+		{"overlapping across a corner", circle{geometry.Vector{X: 2, Y: 2}, 10}, circle{geometry.Vector{X: 1020, Y: 764}, 10}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := CollisionDetected(test.a, test.b, screenBounds); got != test.want {
+				t.Errorf("CollisionDetected = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBulletCollisionDetected(t *testing.T) {
+	middle := circle{geometry.Vector{X: 480, Y: 380}, 30}
+	edge := circle{geometry.Vector{X: 10, Y: 380}, 30}
+
+	tests := []struct {
+		name       string
+		position   geometry.Vector
+		wraparound bool
+		target     circle
+		want       bool
+	}{
+		{"on target", geometry.Vector{X: 480, Y: 380}, false, middle, true},
+		{"a screen width away, off screen", geometry.Vector{X: 1504, Y: 380}, false, middle, false},
+		{"a screen height away, off screen", geometry.Vector{X: 480, Y: -388}, false, middle, false},
+		{"on screen, hitting a target straddling the edge", geometry.Vector{X: 1020, Y: 380}, false, edge, true},
+		{"just off screen, beyond a target straddling the edge", geometry.Vector{X: 1030, Y: 380}, false, edge, false},
+		{"wrapping, across the edge from its target", geometry.Vector{X: 1030, Y: 380}, true, edge, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bullet := NewBullet(screenBounds, &test.position, 0, sprites.Small, test.wraparound)
+			if got := bullet.CollisionDetected(test.target); got != test.want {
+				t.Errorf("CollisionDetected = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"math/rand"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/particles"
+	"github.com/rm-hull/asteroids/internal/score"
+	"github.com/rm-hull/asteroids/internal/sound"
+)
+
+// Env is the shared context that every entity in a game session is created
+// with: where the screen edges are, the rules, the session's random source,
+// and where to send sound effects and particles.
+type Env struct {
+	ScreenBounds *geometry.Dimension
+	Rules        Rules
+	Rand         *rand.Rand
+	Sounds       sound.Sink
+	Particles    *particles.System
+}
+
+// Rules are the settings the game is played by, which carry over from one
+// game to the next.
+type Rules struct {
+	Hyperspace HyperspaceConfig
+	Score      score.Config
+}
+
+var DefaultRules = Rules{
+	Hyperspace: DefaultHyperspaceConfig,
+	Score:      score.DefaultConfig,
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+type HyperspaceConfig struct {
+	// How long the ship is in transit: it fades out over the first half and
+	// re-materialises at the destination over the second half.
+	Duration time.Duration
+	Cooldown time.Duration
+	// Probability (0..1) that the ship explodes on re-entry, as per the
+	// 1979 original.
+	SelfDestructChance float64
+	// When false, the ship always lands well away from where it jumped.
+	LandAnywhere bool
+}
+
+var DefaultHyperspaceConfig = HyperspaceConfig{
+	Duration:           750 * time.Millisecond,
+	Cooldown:           2 * time.Second,
+	SelfDestructChance: 0.1,
+	LandAnywhere:       false,
+}
+
+type hyperspaceJump struct {
+	timer       *internal.Timer
+	destination *geometry.Vector
+	arrived     bool
+}
+
+func newHyperspaceJump(duration time.Duration, destination *geometry.Vector) *hyperspaceJump {
+	return &hyperspaceJump{
+		timer:       internal.NewTimer(duration),
+		destination: destination,
+		arrived:     false,
+	}
+}
+
+// Visibility runs from 1 down to 0 at the midpoint of the jump and back up
+// to 1 again on arrival.
+func (h *hyperspaceJump) Visibility() float64 {
+	pct := h.timer.PercentComplete()
+	if pct < 0.5 {
+		return 1.0 - pct*2
+	}
+	return (pct - 0.5) * 2
+}
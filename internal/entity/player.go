@@ -1,23 +1,17 @@
 package entity
 
 import (
-	"fmt"
-	"image/color"
+	"maps"
 	"math"
-	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/rm-hull/asteroids/internal"
-	"github.com/rm-hull/asteroids/internal/fonts"
 	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/score"
+	"github.com/rm-hull/asteroids/internal/sound"
 	"github.com/rm-hull/asteroids/internal/sprites"
-	"github.com/rm-hull/asteroids/internal/text_align"
-	"github.com/rm-hull/asteroids/resources/soundfx"
-
-	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/audio"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
-	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
 
 type Player struct {
@@ -27,28 +21,30 @@ type Player struct {
 	shootCooldown    *internal.Timer
 	screenBounds     *geometry.Dimension
 	livesLeft        int
-	score            int
+	score            *score.Score
 	bullets          map[int]*Bullet
 	sequence         *internal.Sequence
 	godMode          bool
 	maxSalvo         int
 	shootingAccuracy float64
+	hyperspace       HyperspaceConfig
+	hyperspaceTimer  *internal.Timer
+	jump             *hyperspaceJump
+	env              *Env
 }
 
 const (
-	numLives           = 3
-	maxSpeed           = 5.0
-	blastRadius        = 40.0
-	deathDuration      = 2 * time.Second
-	cannotDieDuration  = 3 * time.Second
-	cooldownTime       = 100 * time.Millisecond
-	sampleRate         = 44100
-	extraLifeThreshold = 10000
+	numLives          = 3
+	maxSpeed          = 5.0
+	blastRadius       = 40.0
+	exhaustOffset     = 30.0
+	deathDuration     = 2 * time.Second
+	cannotDieDuration = 3 * time.Second
+	cooldownTime      = 100 * time.Millisecond
 )
 
-var audioContext = audio.NewContext(sampleRate)
-
-func NewPlayer(screenBounds *geometry.Dimension) *Player {
+func NewPlayer(env *Env) *Player {
+	screenBounds := env.ScreenBounds
 	sprite := sprites.NewSprite(screenBounds, sprites.SpaceShip1, true)
 	sprite.Position.X = screenBounds.W/2 - sprite.Centre.X
 	sprite.Position.Y = screenBounds.H/2 - sprite.Centre.Y
@@ -59,62 +55,41 @@ func NewPlayer(screenBounds *geometry.Dimension) *Player {
 		shootCooldown:    internal.NewTimer(cooldownTime),
 		screenBounds:     screenBounds,
 		livesLeft:        numLives,
-		score:            0,
+		score:            score.New(env.Rules.Score),
 		bullets:          make(map[int]*Bullet),
 		sequence:         internal.NewSequence(),
 		maxSalvo:         3,
 		shootingAccuracy: 1.0,
 		godMode:          false,
+		hyperspace:       env.Rules.Hyperspace,
+		hyperspaceTimer:  internal.NewTimer(env.Rules.Hyperspace.Cooldown),
+		env:              env,
 	}
 }
 
-func (p *Player) Draw(screen *ebiten.Image) {
-
-	op := &text.DrawOptions{}
-	op.ColorScale.ScaleWithColor(color.White)
-
-	op.GeoM.Translate(0, 30)
-	text.Draw(screen, fmt.Sprintf("LIVES: %d", p.livesLeft), fonts.AsteroidsFace32, op)
-
-	op.GeoM.Translate(400, 0)
-	text.Draw(screen, fmt.Sprintf("SCORE: %d", p.score), fonts.AsteroidsFace32, op)
-
-	op.GeoM.Translate(400, 0)
-	text.Draw(screen, fmt.Sprintf("FPS: %0.2f", ebiten.ActualFPS()), fonts.AsteroidsFace32, op)
-
-	if p.livesLeft == 0 {
-		message := "GAME OVER"
-		x, y := text_align.Center(p.screenBounds, message, fonts.AsteroidsFace64)
-		op.GeoM.Reset()
-		op.GeoM.Translate(float64(x), float64(y))
-		text.Draw(screen, message, fonts.AsteroidsFace64, op)
-
-		message = "PRESS \"R\" TO RESTART"
-		x, _ = text_align.Center(p.screenBounds, message, fonts.AsteroidsFace32)
-		op.GeoM.Reset()
-		op.GeoM.Translate(float64(x), float64(y+96))
-		text.Draw(screen, message, fonts.AsteroidsFace32, op)
-		return
-	}
-
-	for _, bullet := range p.bullets {
-		bullet.Draw(screen)
-	}
+func (p *Player) Sprite() *sprites.Sprite {
+	return p.sprite
+}
 
-	if p.IsDying() {
-		fade := 1.0 - p.deadTimer.PercentComplete()
-		p.sprite.ColorModel.Scale(1.0, 1.0, 1.0, fade)
-	} else if p.CannotDie() {
-		fade := p.cannotDieTimer.PercentComplete()
-		p.sprite.ColorModel.Scale(1.0, 1.0, 1.0, fade)
+// Alpha is how opaque the ship should be drawn: it disappears as it breaks
+// up, fades out as it jumps, and fades in while it cannot be killed at the
+// start of a life.
+func (p *Player) Alpha() float64 {
+	switch {
+	case p.IsDying():
+		return 0.0
+	case p.InHyperspace():
+		return p.jump.Visibility()
+	case p.CannotDie():
+		return p.cannotDieTimer.PercentComplete()
+	default:
+		return 1.0
 	}
-
-	p.sprite.Draw(screen)
 }
 
-func (p *Player) Update() error {
-	if p.livesLeft == 0 {
-		// TODO: Update game state
+func (p *Player) Update(controls input.State) error {
+	if p.IsGameOver() {
+		p.env.Sounds.Stop(sound.Thrust)
 		return nil
 	}
 
@@ -129,17 +104,30 @@ func (p *Player) Update() error {
 		}
 	}
 
+	p.hyperspaceTimer.Update()
 	if p.IsDying() {
 		p.SpinOutOfControl()
+	} else if p.InHyperspace() {
+		p.HandleHyperspace()
 	} else {
-		p.HandleMovement()
-		p.HandleShooting()
+		p.HandleMovement(controls)
+		p.HandleShooting(controls)
+
+		if controls.IsJustPressed(input.Hyperspace) {
+			p.Hyperspace()
+		}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyG) {
+		if controls.IsJustPressed(input.GodMode) {
 			p.ToggleGodMode()
 		}
 	}
 
+	if p.IsThrusting(controls) {
+		p.env.Sounds.Loop(sound.Thrust)
+	} else {
+		p.env.Sounds.Stop(sound.Thrust)
+	}
+
 	p.cannotDieTimer.Update()
 	if err := p.sprite.Update(); err != nil {
 		return err
@@ -148,41 +136,80 @@ func (p *Player) Update() error {
 	return nil
 }
 
-func (p *Player) HandleMovement() {
-	if ebiten.IsKeyPressed(ebiten.KeyLeft) {
-		p.sprite.Direction -= math.Pi / float64(ebiten.TPS())
-	} else if ebiten.IsKeyPressed(ebiten.KeyRight) {
-		p.sprite.Direction += math.Pi / float64(ebiten.TPS())
+func (p *Player) HandleMovement(controls input.State) {
+	if controls.IsPressed(input.RotateLeft) {
+		p.sprite.Direction -= math.Pi / internal.TicksPerSecond
+	} else if controls.IsPressed(input.RotateRight) {
+		p.sprite.Direction += math.Pi / internal.TicksPerSecond
 	}
 	p.sprite.Orientation = p.sprite.Direction
 
 	// Thrusting?
-	if ebiten.IsKeyPressed(ebiten.KeyUp) {
+	if controls.IsPressed(input.Thrust) {
 		p.sprite.MoveForward(0.2, maxSpeed)
-		p.sprite.Image = sprites.SpaceShip2
-		sfxPlayer := audioContext.NewPlayerFromBytes(soundfx.Thrust)
-		sfxPlayer.SetVolume(0.1)
-		sfxPlayer.Play()
+		p.sprite.Frame = sprites.SpaceShip2
+
+		exhaust := geometry.Add(p.Position(), geometry.VectorFrom(p.sprite.Direction+math.Pi, exhaustOffset))
+		p.env.Particles.Exhaust(*exhaust, *p.sprite.Velocity, p.sprite.Direction+math.Pi)
 
 	} else {
 		// Back to normal
-		p.sprite.Image = sprites.SpaceShip1
+		p.sprite.Frame = sprites.SpaceShip1
 	}
 }
 
-func (p *Player) HandleShooting() {
+func (p *Player) IsThrusting(controls input.State) bool {
+	return !p.IsGameOver() && !p.IsDying() && !p.InHyperspace() && controls.IsPressed(input.Thrust)
+}
+
+func (p *Player) HandleShooting(controls input.State) {
 	p.shootCooldown.Update()
-	if p.shootCooldown.IsReady() && len(p.bullets) < p.maxSalvo && (ebiten.IsKeyPressed(ebiten.KeyShiftLeft) || ebiten.IsKeyPressed(ebiten.KeySpace)) {
+	if p.shootCooldown.IsReady() && len(p.bullets) < p.maxSalvo && controls.IsPressed(input.Fire) {
 		p.shootCooldown.Reset()
 
 		direction := p.sprite.Direction + p.ShootingJitter()
 		spawnPosn := geometry.Add(p.Position(), geometry.VectorFrom(p.sprite.Direction, blastRadius))
-		p.bullets[p.sequence.GetNext()] = NewBullet(p.screenBounds, spawnPosn, direction, sprites.Small)
+		p.bullets[p.sequence.GetNext()] = NewBullet(p.screenBounds, spawnPosn, direction, sprites.Small, false)
+		p.env.Sounds.Play(sound.PlayerFire)
+	}
+}
 
-		sfxPlayer := audioContext.NewPlayerFromBytes(soundfx.LazerGunShot2)
-		sfxPlayer.SetVolume(0.5)
-		sfxPlayer.Play()
+func (p *Player) Hyperspace() {
+	if p.InHyperspace() || !p.hyperspaceTimer.IsReady() {
+		return
 	}
+
+	var destination *geometry.Vector
+	if p.hyperspace.LandAnywhere {
+		destination = p.randomPosition(0)
+	} else {
+		destination = p.NotNear()
+	}
+
+	p.jump = newHyperspaceJump(p.hyperspace.Duration, destination)
+	p.hyperspaceTimer.Reset()
+}
+
+func (p *Player) HandleHyperspace() {
+	p.jump.timer.Update()
+
+	if !p.jump.arrived && p.jump.timer.PercentComplete() >= 0.5 {
+		p.sprite.Reset()
+		p.sprite.Position.X = p.jump.destination.X - p.sprite.Centre.X
+		p.sprite.Position.Y = p.jump.destination.Y - p.sprite.Centre.Y
+		p.jump.arrived = true
+	}
+
+	if p.jump.timer.IsReady() {
+		p.jump = nil
+		if p.env.Rand.Float64() < p.hyperspace.SelfDestructChance {
+			p.Kill()
+		}
+	}
+}
+
+func (p *Player) InHyperspace() bool {
+	return p.jump != nil
 }
 
 func (p *Player) ToggleGodMode() {
@@ -200,29 +227,26 @@ func (p *Player) ToggleGodMode() {
 }
 
 func (p *Player) ShootingJitter() float64 {
-	return (rand.Float64() - 0.5) * (1 - p.shootingAccuracy)
+	return (p.env.Rand.Float64() - 0.5) * (1 - p.shootingAccuracy)
 }
 
 func (p *Player) SpinOutOfControl() {
-	p.sprite.Orientation += 3 * math.Pi / float64(ebiten.TPS())
+	p.sprite.Orientation += 3 * math.Pi / internal.TicksPerSecond
 	p.deadTimer.Update()
 
 	if p.deadTimer.IsReady() {
 		p.Prepare()
 		p.livesLeft--
-		if p.livesLeft == 0 {
-			sfxPlayer := audioContext.NewPlayerFromBytes(soundfx.GameOver)
-			sfxPlayer.Play()
-		}
 	}
 }
 
 func (p *Player) Prepare() {
 	p.deadTimer = nil
+	p.jump = nil
 	p.sprite.Reset()
 	p.sprite.Position.X = p.screenBounds.W/2 - p.sprite.Centre.X
 	p.sprite.Position.Y = p.screenBounds.H/2 - p.sprite.Centre.Y
-	p.sprite.Image = sprites.SpaceShip1
+	p.sprite.Frame = sprites.SpaceShip1
 	p.cannotDieTimer.Reset()
 	for idx := range p.bullets {
 		delete(p.bullets, idx)
@@ -234,36 +258,48 @@ func (p *Player) Kill() {
 		return
 	}
 	p.deadTimer = internal.NewTimer(deathDuration)
-
-	sePlayer := audioContext.NewPlayerFromBytes(soundfx.Explosion1)
-	sePlayer.Play()
+	p.env.Sounds.Play(sound.PlayerExplosion)
+	p.env.Particles.ShipExplosion(*p.Position(), *p.sprite.Velocity, p.Hull())
 }
 
 func (p *Player) NotNear() *geometry.Vector {
-	halfH := p.screenBounds.H / 2
-	sqHalfH := halfH * halfH
+	return p.randomPosition(p.screenBounds.H / 2)
+}
+
+// randomPosition samples somewhere on screen at least minDistance away from
+// the player's current position; a minDistance of zero allows anywhere.
+func (p *Player) randomPosition(minDistance float64) *geometry.Vector {
+	sqMinDistance := minDistance * minDistance
 
 	for {
 		position := geometry.Vector{
-			X: rand.Float64() * p.screenBounds.W,
-			Y: rand.Float64() * p.screenBounds.H,
+			X: p.env.Rand.Float64() * p.screenBounds.W,
+			Y: p.env.Rand.Float64() * p.screenBounds.H,
 		}
 
-		if p.sprite.Position.SquareDistanceFrom(&position) > sqHalfH {
+		if p.Position().ToroidalSquareDistanceFrom(&position, p.screenBounds) >= sqMinDistance {
 			return &position
 		}
 	}
 }
 
 func (p *Player) UpdateScore(value int) {
-	if math.Mod(float64(p.score), extraLifeThreshold) > math.Mod(float64(p.score+value), extraLifeThreshold) {
-		p.livesLeft++
-
-		sfxPlayer := audioContext.NewPlayerFromBytes(soundfx.ExtraLife)
-		sfxPlayer.Play()
+	if extraLives := p.score.Add(value); extraLives > 0 {
+		p.livesLeft += extraLives
+		p.env.Sounds.Play(sound.ExtraLife)
 	}
+}
+
+func (p *Player) Score() *score.Score {
+	return p.score
+}
 
-	p.score += value
+func (p *Player) LivesLeft() int {
+	return p.livesLeft
+}
+
+func (p *Player) IsGameOver() bool {
+	return p.livesLeft == 0
 }
 
 func (p *Player) IsDying() bool {
@@ -271,7 +307,7 @@ func (p *Player) IsDying() bool {
 }
 
 func (p *Player) IsAlive() bool {
-	return !p.IsDying() && !p.CannotDie()
+	return !p.IsDying() && !p.CannotDie() && !p.InHyperspace()
 }
 
 func (p *Player) CannotDie() bool {
@@ -279,13 +315,21 @@ func (p *Player) CannotDie() bool {
 }
 
 func (p *Player) Bullets(callback func(bullet *Bullet)) {
-	for _, bullet := range p.bullets {
-		callback(bullet)
+	for _, idx := range slices.Sorted(maps.Keys(p.bullets)) {
+		callback(p.bullets[idx])
 	}
 }
 
 func (p *Player) Size() float64 {
-	return p.sprite.Centre.Y * 0.65
+	return p.sprite.Frame.Radius
+}
+
+func (p *Player) Hull() []geometry.Vector {
+	return p.sprite.Hull()
+}
+
+func (p *Player) Reach() float64 {
+	return p.sprite.Frame.Reach
 }
 
 func (p *Player) Position() *geometry.Vector {
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/particles"
+	"github.com/rm-hull/asteroids/internal/sound"
+)
+
+// thrustSink remembers whether the thrust loop was last started or stopped.
+type thrustSink struct {
+	sound.Mute
+	looping bool
+}
+
+func (s *thrustSink) Loop(effect sound.Effect) {
+	if effect == sound.Thrust {
+		s.looping = true
+	}
+}
+
+func (s *thrustSink) Stop(effect sound.Effect) {
+	if effect == sound.Thrust {
+		s.looping = false
+	}
+}
+
+func TestThrustStopsOnGameOver(t *testing.T) {
+	sounds := &thrustSink{}
+	player := NewPlayer(&Env{
+		ScreenBounds: screenBounds,
+		Rules:        DefaultRules,
+		Rand:         rand.New(rand.NewSource(1)),
+		Sounds:       sounds,
+		Particles:    particles.NewSystem(screenBounds, 1),
+	})
+	player.livesLeft = 1
+
+	var controls input.State
+	controls.Update(input.Actions(0).With(input.Thrust))
+
+	for range 10 * internal.TicksPerSecond {
+		if player.IsAlive() && !player.CannotDie() {
+			player.Kill()
+		}
+		if err := player.Update(controls); err != nil {
+			t.Fatal(err)
+		}
+		if player.IsGameOver() {
+			break
+		}
+	}
+
+	if !player.IsGameOver() {
+		t.Fatal("player never ran out of lives")
+	}
+	if player.IsThrusting(controls) {
+		t.Error("still thrusting after game over")
+	}
+	if err := player.Update(controls); err != nil {
+		t.Fatal(err)
+	}
+	if sounds.looping {
+		t.Error("thrust sound still looping after game over")
+	}
+}
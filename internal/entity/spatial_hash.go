@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"math"
+	"slices"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+// SpatialHash divides the screen into a grid of cells, and files colliders
+// under every cell their bounding circle overlaps, so that only those filed
+// near each other need checking for a collision. The grid wraps around at
+// the edges in the same way as the screen does.
+type SpatialHash struct {
+	cols, rows   int
+	cellW, cellH float64
+	cells        [][]int
+	near         []int
+}
+
+// NewSpatialHash sizes the cells as close to cellSize as will exactly tile
+// the screen.
+func NewSpatialHash(screenBounds *geometry.Dimension, cellSize float64) *SpatialHash {
+	cols := max(1, int(math.Ceil(screenBounds.W/cellSize)))
+	rows := max(1, int(math.Ceil(screenBounds.H/cellSize)))
+
+	return &SpatialHash{
+		cols:  cols,
+		rows:  rows,
+		cellW: screenBounds.W / float64(cols),
+		cellH: screenBounds.H / float64(rows),
+		cells: make([][]int, cols*rows),
+	}
+}
+
+func (h *SpatialHash) Clear() {
+	for i := range h.cells {
+		h.cells[i] = h.cells[i][:0]
+	}
+}
+
+// Insert files the collider under the given key, e.g. its index in a map.
+func (h *SpatialHash) Insert(key int, collider Collider) {
+	h.visit(collider, func(cell int) {
+		h.cells[cell] = append(h.cells[cell], key)
+	})
+}
+
+// Near returns, in ascending order, the keys of everything filed in the
+// same cells as the collider: anything it could possibly be touching. The
+// slice is reused, so is only good until the next call.
+func (h *SpatialHash) Near(collider Collider) []int {
+	h.near = h.near[:0]
+	h.visit(collider, func(cell int) {
+		h.near = append(h.near, h.cells[cell]...)
+	})
+
+	slices.Sort(h.near)
+	h.near = slices.Compact(h.near)
+	return h.near
+}
+
+func (h *SpatialHash) visit(collider Collider, callback func(cell int)) {
+	position := collider.Position()
+	size := BoundingRadius(collider)
+
+	minCol, maxCol := span(position.X, size, h.cellW, h.cols)
+	minRow, maxRow := span(position.Y, size, h.cellH, h.rows)
+
+	for row := minRow; row <= maxRow; row++ {
+		for col := minCol; col <= maxCol; col++ {
+			callback(wrap(row, h.rows)*h.cols + wrap(col, h.cols))
+		}
+	}
+}
+
+// span is the range of cells covered from centre-size to centre+size, which
+// may run off either end of the grid, but never covers any cell twice.
+func span(centre float64, size float64, cellSize float64, count int) (int, int) {
+	first := int(math.Floor((centre - size) / cellSize))
+	last := int(math.Floor((centre + size) / cellSize))
+	if last-first >= count {
+		return 0, count - 1
+	}
+	return first, last
+}
+
+func wrap(i int, count int) int {
+	return ((i % count) + count) % count
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+const (
+	// How far in front of the saucer its bullets appear.
+	alienMuzzle = 60.0
+
+	minAlienAccuracy = 0.6
+	maxAlienAccuracy = 0.95
+)
+
+// alienAccuracy is how true the saucer's aim is on a given level.
+func alienAccuracy(level int) float64 {
+	return math.Min(maxAlienAccuracy, minAlienAccuracy+0.05*float64(level-1))
+}
+
+// leadShot is the direction to fire in so that a bullet meets the player
+// where they will be, rather than where they are. Each wrapped image of the
+// player is considered, as the way through a screen edge is sometimes the
+// quicker, and the soonest interception within the bullet's range wins. If
+// the player can't be caught, it fires straight at them the short way round.
+func (a *Alien) leadShot() float64 {
+	origin := a.Position()
+	target := a.player.Position()
+	velocity := *a.player.sprite.Velocity
+
+	nearest := toroidalOffset(origin, target, a.screenBounds)
+	direction := math.Atan2(nearest.Y, nearest.X)
+	soonest := float64(internal.Ticks(bulletLifetime))
+
+	for _, dx := range []float64{-a.screenBounds.W, 0, a.screenBounds.W} {
+		for _, dy := range []float64{-a.screenBounds.H, 0, a.screenBounds.H} {
+			offset := geometry.Vector{X: target.X + dx - origin.X, Y: target.Y + dy - origin.Y}
+
+			t, ok := interceptTime(offset, velocity, bulletSpeed, alienMuzzle)
+			if !ok || t > soonest {
+				continue
+			}
+
+			soonest = t
+			direction = math.Atan2(offset.Y+velocity.Y*t, offset.X+velocity.X*t)
+		}
+	}
+	return direction
+}
+
+// interceptTime is how many ticks until a bullet, fired at speed from muzzle
+// ahead of the origin, can meet a target at offset moving with velocity: the
+// earliest t for which |offset + velocity*t| = muzzle + speed*t.
+func interceptTime(offset, velocity geometry.Vector, speed, muzzle float64) (float64, bool) {
+	a := dot(velocity, velocity) - speed*speed
+	b := 2 * (dot(offset, velocity) - muzzle*speed)
+	c := dot(offset, offset) - muzzle*muzzle
+
+	if c <= 0 {
+		return 0, true
+	}
+
+	if math.Abs(a) < 1e-9 {
+		if b >= 0 {
+			return 0, false
+		}
+		return -c / b, true
+	}
+
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+
+	root := math.Sqrt(discriminant)
+	t1, t2 := (-b-root)/(2*a), (-b+root)/(2*a)
+	if t1 > t2 {
+		t1, t2 = t2, t1
+	}
+
+	switch {
+	case t1 > 0:
+		return t1, true
+	case t2 > 0:
+		return t2, true
+	default:
+		return 0, false
+	}
+}
+
+func dot(a, b geometry.Vector) float64 {
+	return a.X*b.X + a.Y*b.Y
+}
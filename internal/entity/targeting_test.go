@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+func TestInterceptTime(t *testing.T) {
+	const speed, muzzle = 8.0, 60.0
+
+	tests := []struct {
+		name     string
+		offset   geometry.Vector
+		velocity geometry.Vector
+		want     float64
+		ok       bool
+	}{
+		{"stationary", geometry.Vector{X: 200}, geometry.Vector{}, 17.5, true},
+		{"within the muzzle", geometry.Vector{X: 50}, geometry.Vector{}, 0, true},
+		{"approaching", geometry.Vector{X: 300}, geometry.Vector{X: -2}, 24, true},
+		{"approaching as fast as a bullet", geometry.Vector{X: 200}, geometry.Vector{X: -8}, 8.75, true},
+		{"crossing", geometry.Vector{X: 200}, geometry.Vector{Y: 4}, 19.297326385411576, true},
+		{"receding", geometry.Vector{X: 200}, geometry.Vector{X: 4}, 35, true},
+		{"receding as fast as a bullet", geometry.Vector{X: 200}, geometry.Vector{X: 8}, 0, false},
+		{"receding faster than a bullet", geometry.Vector{X: 200}, geometry.Vector{X: 10}, 0, false},
+		{"too fast to catch going past", geometry.Vector{Y: 200}, geometry.Vector{X: 12}, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := interceptTime(test.offset, test.velocity, speed, muzzle)
+			if ok != test.ok || math.Abs(got-test.want) > 1e-9 {
+				t.Fatalf("interceptTime = %v, %t, want %v, %t", got, ok, test.want, test.ok)
+			}
+			if !ok || got == 0 {
+				return
+			}
+
+			// The target is exactly where the bullet has reached.
+			at := geometry.Vector{X: test.offset.X + test.velocity.X*got, Y: test.offset.Y + test.velocity.Y*got}
+			if reached := muzzle + speed*got; math.Abs(at.Magnitude()-reached) > 1e-9 {
+				t.Errorf("target is %v away at t=%v, bullet has reached %v", at.Magnitude(), got, reached)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package geometry
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// ConvexHull returns the smallest convex polygon containing all the points,
+// with its vertices in order around the edge.
+func ConvexHull(points []Vector) []Vector {
+	if len(points) < 3 {
+		return slices.Clone(points)
+	}
+
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b Vector) int {
+		if a.X != b.X {
+			return cmp.Compare(a.X, b.X)
+		}
+		return cmp.Compare(a.Y, b.Y)
+	})
+
+	// Andrew's monotone chain: the lower then the upper half of the hull.
+	hull := make([]Vector, 0, 2*len(sorted))
+	for _, pass := range [][]Vector{sorted, reversed(sorted)} {
+		start := len(hull)
+		for _, p := range pass {
+			for len(hull) >= start+2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		hull = hull[:len(hull)-1]
+	}
+	return hull
+}
+
+// Simplify drops the vertices which contribute least to the area of a
+// convex polygon until it has no more than maxVertices. The result is still
+// convex, and lies inside the original.
+func Simplify(polygon []Vector, maxVertices int) []Vector {
+	polygon = slices.Clone(polygon)
+	for len(polygon) > max(maxVertices, 3) {
+		smallest, smallestArea := 0, math.Inf(1)
+		for i := range polygon {
+			prev := polygon[(i+len(polygon)-1)%len(polygon)]
+			next := polygon[(i+1)%len(polygon)]
+			if area := math.Abs(cross(prev, polygon[i], next)); area < smallestArea {
+				smallest, smallestArea = i, area
+			}
+		}
+		polygon = slices.Delete(polygon, smallest, smallest+1)
+	}
+	return polygon
+}
+
+// Radius is the distance from the origin to the furthest vertex.
+func Radius(polygon []Vector) float64 {
+	radius := 0.0
+	for _, v := range polygon {
+		radius = math.Max(radius, v.Magnitude())
+	}
+	return radius
+}
+
+// PolygonsOverlap tests two convex polygons with the separating axis
+// theorem: they overlap unless some edge normal separates them.
+func PolygonsOverlap(a, b []Vector) bool {
+	for _, polygon := range [][]Vector{a, b} {
+		for i := range polygon {
+			axis := normal(polygon[i], polygon[(i+1)%len(polygon)])
+			minA, maxA := project(a, axis)
+			minB, maxB := project(b, axis)
+			if maxA < minB || maxB < minA {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// PolygonCircleOverlap is PolygonsOverlap for a convex polygon and a circle,
+// which additionally needs the axis from the circle to the nearest vertex.
+func PolygonCircleOverlap(polygon []Vector, centre Vector, radius float64) bool {
+	axes := make([]Vector, 0, len(polygon)+1)
+	for i := range polygon {
+		axes = append(axes, normal(polygon[i], polygon[(i+1)%len(polygon)]))
+	}
+
+	nearest, nearestDist := polygon[0], math.Inf(1)
+	for _, v := range polygon {
+		if dist := v.SquareDistanceFrom(&centre); dist < nearestDist {
+			nearest, nearestDist = v, dist
+		}
+	}
+	if nearestDist > 0 {
+		toVertex := Vector{X: nearest.X - centre.X, Y: nearest.Y - centre.Y}
+		axes = append(axes, toVertex.Normalize())
+	}
+
+	for _, axis := range axes {
+		minP, maxP := project(polygon, axis)
+		c := dot(centre, axis)
+		if maxP < c-radius || c+radius < minP {
+			return false
+		}
+	}
+	return true
+}
+
+// Translate returns a copy of the polygon moved by offset.
+func Translate(polygon []Vector, offset Vector) []Vector {
+	moved := make([]Vector, len(polygon))
+	for i, v := range polygon {
+		moved[i] = Vector{X: v.X + offset.X, Y: v.Y + offset.Y}
+	}
+	return moved
+}
+
+func project(polygon []Vector, axis Vector) (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range polygon {
+		p := dot(v, axis)
+		lo = math.Min(lo, p)
+		hi = math.Max(hi, p)
+	}
+	return lo, hi
+}
+
+func normal(a, b Vector) Vector {
+	edge := Vector{X: b.Y - a.Y, Y: a.X - b.X}
+	return edge.Normalize()
+}
+
+func dot(a, b Vector) float64 {
+	return a.X*b.X + a.Y*b.Y
+}
+
+func cross(o, a, b Vector) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+func reversed(points []Vector) []Vector {
+	r := slices.Clone(points)
+	slices.Reverse(r)
+	return r
+}
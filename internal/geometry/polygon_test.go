@@ -0,0 +1,137 @@
+package geometry
+
+import (
+	"slices"
+	"testing"
+)
+
+// square is the axis-aligned square from (x, y) with sides of the given size.
+func square(x, y, size float64) []Vector {
+	return []Vector{{X: x, Y: y}, {X: x + size, Y: y}, {X: x + size, Y: y + size}, {X: x, Y: y + size}}
+}
+
+func TestConvexHull(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Vector
+		want   []Vector
+	}{
+		{"too few points", []Vector{{X: 1, Y: 2}, {X: 3, Y: 4}}, []Vector{{X: 1, Y: 2}, {X: 3, Y: 4}}},
+		{"already convex", square(0, 0, 2), square(0, 0, 2)},
+		{
+			"interior and edge points dropped",
+			[]Vector{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 1, Y: 0}, {X: 0, Y: 0}, {X: 2, Y: 0}, {X: 0, Y: 1}},
+			square(0, 0, 2),
+		},
+		{
+			"triangle",
+			[]Vector{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 2, Y: 1}, {X: 2, Y: 3}, {X: 1, Y: 1}},
+			[]Vector{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 2, Y: 3}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ConvexHull(test.points); !slices.Equal(got, test.want) {
+				t.Errorf("ConvexHull = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	octagon := []Vector{
+		{X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 1}, {X: 3, Y: 2},
+		{X: 2, Y: 3}, {X: 1, Y: 3}, {X: 0, Y: 2}, {X: 0, Y: 1},
+	}
+	// A square with one corner barely clipped off.
+	clipped := []Vector{{X: 0, Y: 0}, {X: 9.9, Y: 0}, {X: 10, Y: 0.1}, {X: 10, Y: 10}, {X: 0, Y: 10}}
+
+	tests := []struct {
+		name        string
+		polygon     []Vector
+		maxVertices int
+		want        int
+	}{
+		{"already small enough", octagon, 8, 8},
+		{"down to four", octagon, 4, 4},
+		{"never below a triangle", octagon, 1, 3},
+		{"the least significant corner goes", clipped, 4, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Simplify(test.polygon, test.maxVertices)
+			if len(got) != test.want {
+				t.Fatalf("Simplify = %v, want %d vertices", got, test.want)
+			}
+			for _, v := range got {
+				if !slices.Contains(test.polygon, v) {
+					t.Errorf("Simplify = %v, which has a vertex %v not in the original", got, v)
+				}
+			}
+		})
+	}
+
+	if got := Simplify(clipped, 4); slices.Contains(got, Vector{X: 9.9, Y: 0}) && slices.Contains(got, Vector{X: 10, Y: 0.1}) {
+		t.Errorf("Simplify = %v, kept both vertices of the clipped corner", got)
+	}
+}
+
+func TestPolygonsOverlap(t *testing.T) {
+	triangle := []Vector{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+
+	tests := []struct {
+		name string
+		a, b []Vector
+		want bool
+	}{
+		{"overlapping", square(0, 0, 10), square(5, 5, 10), true},
+		{"one inside the other", square(0, 0, 10), square(2, 2, 2), true},
+		{"separated", square(0, 0, 10), square(20, 0, 10), false},
+		{"touching edges", square(0, 0, 10), square(10, 0, 10), true},
+		// Their bounding boxes overlap, but the triangle's long edge
+		// separates them.
+		{"separated on the diagonal", triangle, square(6, 6, 4), false},
+		{"overlapping on the diagonal", triangle, square(4, 4, 4), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := PolygonsOverlap(test.a, test.b); got != test.want {
+				t.Errorf("PolygonsOverlap = %t, want %t", got, test.want)
+			}
+			if got := PolygonsOverlap(test.b, test.a); got != test.want {
+				t.Errorf("PolygonsOverlap (swapped) = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPolygonCircleOverlap(t *testing.T) {
+	box := square(0, 0, 10)
+
+	tests := []struct {
+		name   string
+		centre Vector
+		radius float64
+		want   bool
+	}{
+		{"inside", Vector{X: 5, Y: 5}, 1, true},
+		{"overlapping an edge", Vector{X: 12, Y: 5}, 3, true},
+		{"beside an edge", Vector{X: 12, Y: 5}, 1.5, false},
+		{"overlapping a vertex", Vector{X: 12, Y: 12}, 3, true},
+		// Within reach of both edges' lines, but not of the corner itself:
+		// only the axis through the nearest vertex separates them.
+		{"beside a vertex", Vector{X: 12, Y: 12}, 2.5, false},
+		{"far away", Vector{X: 50, Y: 50}, 5, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := PolygonCircleOverlap(box, test.centre, test.radius); got != test.want {
+				t.Errorf("PolygonCircleOverlap = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -49,6 +49,24 @@ func (v *Vector) DistanceFrom(other *Vector) float64 {
 	return math.Sqrt(v.SquareDistanceFrom(other))
 }
 
+// ToroidalSquareDistanceFrom is like SquareDistanceFrom, but on a screen of
+// the given bounds that wraps around at the edges, so the shortest way
+// between the two points may be across an edge.
+func (v *Vector) ToroidalSquareDistanceFrom(other *Vector, bounds *Dimension) float64 {
+	dx := wrappedDelta(v.X-other.X, bounds.W)
+	dy := wrappedDelta(v.Y-other.Y, bounds.H)
+	return (dx*dx + dy*dy)
+}
+
+func (v *Vector) ToroidalDistanceFrom(other *Vector, bounds *Dimension) float64 {
+	return math.Sqrt(v.ToroidalSquareDistanceFrom(other, bounds))
+}
+
+func wrappedDelta(delta float64, size float64) float64 {
+	delta = math.Mod(math.Abs(delta), size)
+	return math.Min(delta, size-delta)
+}
+
 func Add(a, b *Vector) *Vector {
 	return &Vector{X: a.X + b.X, Y: a.Y + b.Y}
 }
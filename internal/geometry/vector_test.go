@@ -0,0 +1,35 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+)
+
+func TestToroidalDistanceFrom(t *testing.T) {
+	bounds := &Dimension{W: 1024, H: 768}
+
+	tests := []struct {
+		name string
+		a, b Vector
+		want float64
+	}{
+		{"same point", Vector{X: 100, Y: 100}, Vector{X: 100, Y: 100}, 0},
+		{"no wrapping needed", Vector{X: 100, Y: 100}, Vector{X: 340, Y: 280}, 300},
+		{"across the left and right edges", Vector{X: 10, Y: 300}, Vector{X: 1014, Y: 300}, 20},
+		{"across the top and bottom edges", Vector{X: 500, Y: 758}, Vector{X: 500, Y: 10}, 20},
+		{"across a corner", Vector{X: 1020, Y: 764}, Vector{X: 2, Y: 4}, 10},
+		{"exactly half way across", Vector{X: 0, Y: 0}, Vector{X: 512, Y: 0}, 512},
+		{"off screen", Vector{X: -10, Y: 300}, Vector{X: 1034, Y: 300}, 20},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.a.ToroidalDistanceFrom(&test.b, bounds); math.Abs(got-test.want) > 1e-9 {
+				t.Errorf("%v to %v = %v, want %v", test.a, test.b, got, test.want)
+			}
+			if got := test.b.ToroidalDistanceFrom(&test.a, bounds); math.Abs(got-test.want) > 1e-9 {
+				t.Errorf("%v to %v = %v, want %v", test.b, test.a, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package highscore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Bump this whenever the persisted layout changes, and teach decode how to
+// migrate from the previous version.
+const formatVersion = 1
+
+type Store interface {
+	Load() (*Table, error)
+	Save(table *Table) error
+}
+
+type document struct {
+	Version int     `json:"version"`
+	Entries []Entry `json:"entries"`
+}
+
+func encode(table *Table) ([]byte, error) {
+	return json.MarshalIndent(document{
+		Version: formatVersion,
+		Entries: table.entries,
+	}, "", "  ")
+}
+
+func decode(data []byte, size int) (*Table, error) {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	if doc.Version != formatVersion {
+		return nil, fmt.Errorf("unsupported high score format version: %d", doc.Version)
+	}
+
+	sort.SliceStable(doc.Entries, func(i, j int) bool {
+		return doc.Entries[i].Score > doc.Entries[j].Score
+	})
+
+	table := NewTable(size)
+	for _, entry := range doc.Entries {
+		table.Add(entry)
+	}
+	return table, nil
+}
@@ -0,0 +1,57 @@
+//go:build !js
+
+package highscore
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+type fileStore struct {
+	path string
+	size int
+}
+
+// NewStore persists the table as a JSON document under the user's config
+// directory, e.g. ~/.config/asteroids/highscores.json on Linux.
+func NewStore(size int) (Store, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileStore{
+		path: filepath.Join(dir, "asteroids", "highscores.json"),
+		size: size,
+	}, nil
+}
+
+func (s *fileStore) Load() (*Table, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewTable(s.size), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decode(data, s.size)
+}
+
+func (s *fileStore) Save(table *Table) error {
+	data, err := encode(table)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,46 @@
+//go:build js
+
+package highscore
+
+import (
+	"errors"
+	"syscall/js"
+)
+
+const storageKey = "asteroids.highscores"
+
+type localStorageStore struct {
+	storage js.Value
+	size    int
+}
+
+// NewStore persists the table in the browser's localStorage.
+func NewStore(size int) (Store, error) {
+	storage := js.Global().Get("localStorage")
+	if storage.IsUndefined() || storage.IsNull() {
+		return nil, errors.New("localStorage is not available")
+	}
+
+	return &localStorageStore{
+		storage: storage,
+		size:    size,
+	}, nil
+}
+
+func (s *localStorageStore) Load() (*Table, error) {
+	item := s.storage.Call("getItem", storageKey)
+	if item.IsNull() {
+		return NewTable(s.size), nil
+	}
+	return decode([]byte(item.String()), s.size)
+}
+
+func (s *localStorageStore) Save(table *Table) error {
+	data, err := encode(table)
+	if err != nil {
+		return err
+	}
+
+	s.storage.Call("setItem", storageKey, string(data))
+	return nil
+}
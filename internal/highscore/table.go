@@ -0,0 +1,65 @@
+package highscore
+
+import (
+	"sort"
+	"time"
+)
+
+const DefaultSize = 10
+
+type Entry struct {
+	Initials string    `json:"initials"`
+	Score    int       `json:"score"`
+	Level    int       `json:"level"`
+	Date     time.Time `json:"date"`
+}
+
+type Table struct {
+	size    int
+	entries []Entry
+}
+
+func NewTable(size int) *Table {
+	return &Table{
+		size:    size,
+		entries: make([]Entry, 0, size),
+	}
+}
+
+func (t *Table) Entries() []Entry {
+	return t.entries
+}
+
+// Qualifies reports whether a score is good enough to earn a place in the
+// table. Ties with the lowest entry do not displace it.
+func (t *Table) Qualifies(score int) bool {
+	if score <= 0 {
+		return false
+	}
+	if len(t.entries) < t.size {
+		return true
+	}
+	return score > t.entries[len(t.entries)-1].Score
+}
+
+// Add inserts the entry in score order, dropping the lowest entry if the
+// table is full, and returns its rank (zero-based), or -1 if it didn't make
+// the cut.
+func (t *Table) Add(entry Entry) int {
+	if !t.Qualifies(entry.Score) {
+		return -1
+	}
+
+	rank := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].Score < entry.Score
+	})
+
+	t.entries = append(t.entries, Entry{})
+	copy(t.entries[rank+1:], t.entries[rank:])
+	t.entries[rank] = entry
+
+	if len(t.entries) > t.size {
+		t.entries = t.entries[:t.size]
+	}
+	return rank
+}
@@ -0,0 +1,62 @@
+package input
+
+import "fmt"
+
+type Action int
+
+const (
+	RotateLeft Action = iota
+	RotateRight
+	Thrust
+	Fire
+	Hyperspace
+	Start
+	Pause
+	Restart
+	Fullscreen
+	Quit
+	GodMode
+	numActions
+)
+
+var actionNames = [numActions]string{
+	RotateLeft:  "rotate_left",
+	RotateRight: "rotate_right",
+	Thrust:      "thrust",
+	Fire:        "fire",
+	Hyperspace:  "hyperspace",
+	Start:       "start",
+	Pause:       "pause",
+	Restart:     "restart",
+	Fullscreen:  "fullscreen",
+	Quit:        "quit",
+	GodMode:     "god_mode",
+}
+
+func (a Action) String() string {
+	if a < 0 || a >= numActions {
+		return fmt.Sprintf("Action(%d)", int(a))
+	}
+	return actionNames[a]
+}
+
+func ParseAction(name string) (Action, error) {
+	for action, actionName := range actionNames {
+		if actionName == name {
+			return Action(action), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown action: %q", name)
+}
+
+// Actions is a set of actions, one bit per action, small enough to be
+// cheaply copied around every tick.
+type Actions uint32
+
+func (a Actions) Has(action Action) bool {
+	return a&(1<<action) != 0
+}
+
+func (a Actions) With(action Action) Actions {
+	return a | (1 << action)
+}
@@ -0,0 +1,31 @@
+package input
+
+type Source interface {
+	Poll() Actions
+}
+
+// Controller merges the actions from every attached source, so the game
+// doesn't care which device a player happens to be using.
+type Controller struct {
+	State
+	sources []Source
+	pending Actions
+}
+
+func NewController(sources ...Source) *Controller {
+	return &Controller{sources: sources}
+}
+
+// Poll samples every source, holding on to whatever is pressed until the
+// next Update, so a brief tap in between simulation ticks isn't missed.
+func (c *Controller) Poll() {
+	for _, source := range c.sources {
+		c.pending |= source.Poll()
+	}
+}
+
+func (c *Controller) Update() {
+	c.Poll()
+	c.State.Update(c.pending)
+	c.pending = 0
+}
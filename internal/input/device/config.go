@@ -0,0 +1,55 @@
+package device
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/rm-hull/asteroids/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Config is the on-disk representation of the control mappings, keyed by
+// action name, for example:
+//
+//	{
+//	  "keyboard": {
+//	    "rotate_left": ["A", "ArrowLeft"],
+//	    "fire": ["ControlLeft"]
+//	  }
+//	}
+//
+// Any action which is not mentioned keeps its default binding.
+type Config struct {
+	Keyboard map[string][]ebiten.Key `json:"keyboard"`
+}
+
+// LoadKeyBindings reads the config file at path over the top of the
+// defaults. A missing file is not an error.
+func LoadKeyBindings(path string) (KeyBindings, error) {
+	bindings := DefaultKeyBindings()
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return bindings, nil
+	}
+	if err != nil {
+		return bindings, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return bindings, err
+	}
+
+	for name, keys := range config.Keyboard {
+		action, err := input.ParseAction(name)
+		if err != nil {
+			return DefaultKeyBindings(), err
+		}
+		bindings[action] = keys
+	}
+	return bindings, nil
+}
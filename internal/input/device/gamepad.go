@@ -0,0 +1,90 @@
+package device
+
+import (
+	"log"
+
+	"github.com/rm-hull/asteroids/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+const (
+	stickDeadZone    = 0.35
+	triggerThreshold = 0.25
+)
+
+type GamepadBindings map[input.Action][]ebiten.StandardGamepadButton
+
+func DefaultGamepadBindings() GamepadBindings {
+	return GamepadBindings{
+		input.RotateLeft:  {ebiten.StandardGamepadButtonLeftLeft},
+		input.RotateRight: {ebiten.StandardGamepadButtonLeftRight},
+		input.Thrust:      {ebiten.StandardGamepadButtonFrontBottomRight, ebiten.StandardGamepadButtonLeftTop},
+		input.Fire:        {ebiten.StandardGamepadButtonRightBottom, ebiten.StandardGamepadButtonRightLeft},
+		input.Hyperspace:  {ebiten.StandardGamepadButtonRightRight, ebiten.StandardGamepadButtonLeftBottom},
+		input.Start:       {ebiten.StandardGamepadButtonCenterRight},
+		input.Pause:       {ebiten.StandardGamepadButtonCenterRight},
+		input.Restart:     {ebiten.StandardGamepadButtonCenterLeft},
+	}
+}
+
+// Gamepads polls every connected controller which has a standard layout
+// mapping. The set of gamepads is re-read each tick, so controllers can be
+// plugged in or pulled out mid-game.
+type Gamepads struct {
+	bindings GamepadBindings
+	ids      []ebiten.GamepadID
+}
+
+func NewGamepads(bindings GamepadBindings) *Gamepads {
+	return &Gamepads{bindings: bindings}
+}
+
+func (g *Gamepads) Poll() input.Actions {
+	for _, id := range g.ids {
+		if inpututil.IsGamepadJustDisconnected(id) {
+			log.Printf("gamepad disconnected: %d", id)
+		}
+	}
+	for _, id := range inpututil.AppendJustConnectedGamepadIDs(nil) {
+		log.Printf("gamepad connected: %s (standard layout: %t)", ebiten.GamepadName(id), ebiten.IsStandardGamepadLayoutAvailable(id))
+	}
+
+	var actions input.Actions
+	g.ids = ebiten.AppendGamepadIDs(g.ids[:0])
+	for _, id := range g.ids {
+		if ebiten.IsStandardGamepadLayoutAvailable(id) {
+			actions |= g.poll(id)
+		}
+	}
+	return actions
+}
+
+func (g *Gamepads) poll(id ebiten.GamepadID) input.Actions {
+	var actions input.Actions
+	for action, buttons := range g.bindings {
+		for _, button := range buttons {
+			if ebiten.StandardGamepadButtonValue(id, button) > triggerThreshold {
+				actions = actions.With(action)
+				break
+			}
+		}
+	}
+
+	// The left stick (horizontal) steers and pushing it forward thrusts,
+	// alongside the d-pad and trigger bindings above.
+	x := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+	if x < -stickDeadZone {
+		actions = actions.With(input.RotateLeft)
+	} else if x > stickDeadZone {
+		actions = actions.With(input.RotateRight)
+	}
+
+	y := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
+	if y < -stickDeadZone*2 {
+		actions = actions.With(input.Thrust)
+	}
+
+	return actions
+}
@@ -0,0 +1,46 @@
+package device
+
+import (
+	"github.com/rm-hull/asteroids/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type KeyBindings map[input.Action][]ebiten.Key
+
+func DefaultKeyBindings() KeyBindings {
+	return KeyBindings{
+		input.RotateLeft:  {ebiten.KeyArrowLeft},
+		input.RotateRight: {ebiten.KeyArrowRight},
+		input.Thrust:      {ebiten.KeyArrowUp},
+		input.Fire:        {ebiten.KeyShiftLeft, ebiten.KeySpace},
+		input.Hyperspace:  {ebiten.KeyArrowDown},
+		input.Start:       {ebiten.KeyEnter},
+		input.Pause:       {ebiten.KeyP},
+		input.Restart:     {ebiten.KeyR},
+		input.Fullscreen:  {ebiten.KeyF},
+		input.Quit:        {ebiten.KeyQ},
+		input.GodMode:     {ebiten.KeyG},
+	}
+}
+
+type Keyboard struct {
+	bindings KeyBindings
+}
+
+func NewKeyboard(bindings KeyBindings) *Keyboard {
+	return &Keyboard{bindings: bindings}
+}
+
+func (k *Keyboard) Poll() input.Actions {
+	var actions input.Actions
+	for action, keys := range k.bindings {
+		for _, key := range keys {
+			if ebiten.IsKeyPressed(key) {
+				actions = actions.With(action)
+				break
+			}
+		}
+	}
+	return actions
+}
@@ -0,0 +1,111 @@
+package device
+
+import (
+	"image/color"
+
+	"github.com/rm-hull/asteroids/internal/fonts"
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	touchButtonRadius = 56.0
+	touchMargin       = 24.0
+)
+
+var (
+	touchOutline = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x80}
+	touchPressed = color.RGBA{R: 0x80, G: 0x80, B: 0x80, A: 0x80}
+)
+
+type touchButton struct {
+	action  input.Action
+	label   string
+	centre  geometry.Vector
+	radius  float64
+	pressed bool
+}
+
+func (b *touchButton) contains(x, y float64) bool {
+	position := geometry.Vector{X: x, Y: y}
+	return b.centre.SquareDistanceFrom(&position) <= b.radius*b.radius
+}
+
+// TouchControls is an on-screen overlay of virtual buttons. It stays hidden
+// until the first touch is seen, so desktop players never see it. Tapping
+// anywhere away from the buttons counts as Start, so that the title and
+// game over screens can be dismissed.
+type TouchControls struct {
+	buttons []*touchButton
+	ids     []ebiten.TouchID
+	visible bool
+}
+
+func NewTouchControls(screenBounds *geometry.Dimension) *TouchControls {
+	r := touchButtonRadius
+	bottom := screenBounds.H - touchMargin - r
+	right := screenBounds.W - touchMargin - r
+
+	return &TouchControls{
+		buttons: []*touchButton{
+			{action: input.RotateLeft, label: "<", centre: geometry.Vector{X: touchMargin + r, Y: bottom}, radius: r},
+			{action: input.RotateRight, label: ">", centre: geometry.Vector{X: touchMargin*2 + r*3, Y: bottom}, radius: r},
+			{action: input.Fire, label: "FIRE", centre: geometry.Vector{X: right, Y: bottom}, radius: r},
+			{action: input.Thrust, label: "THR", centre: geometry.Vector{X: right - touchMargin - r*2, Y: bottom}, radius: r},
+			{action: input.Hyperspace, label: "HYP", centre: geometry.Vector{X: right, Y: bottom - touchMargin - r*2}, radius: r},
+			{action: input.Pause, label: "II", centre: geometry.Vector{X: right + r/2, Y: 96}, radius: r / 2},
+		},
+	}
+}
+
+func (t *TouchControls) Poll() input.Actions {
+	t.ids = ebiten.AppendTouchIDs(t.ids[:0])
+	if len(t.ids) > 0 {
+		t.visible = true
+	}
+
+	for _, button := range t.buttons {
+		button.pressed = false
+	}
+
+	var actions input.Actions
+	for _, id := range t.ids {
+		x, y := ebiten.TouchPosition(id)
+		actions |= t.press(float64(x), float64(y))
+	}
+	return actions
+}
+
+func (t *TouchControls) press(x, y float64) input.Actions {
+	for _, button := range t.buttons {
+		if button.contains(x, y) {
+			button.pressed = true
+			return input.Actions(0).With(button.action)
+		}
+	}
+	return input.Actions(0).With(input.Start)
+}
+
+func (t *TouchControls) Draw(screen *ebiten.Image) {
+	if !t.visible {
+		return
+	}
+
+	for _, button := range t.buttons {
+		cx, cy, r := float32(button.centre.X), float32(button.centre.Y), float32(button.radius)
+		if button.pressed {
+			vector.FillCircle(screen, cx, cy, r, touchPressed, true)
+		}
+		vector.StrokeCircle(screen, cx, cy, r, 2, touchOutline, true)
+
+		width, height := text.Measure(button.label, fonts.AsteroidsFace32, 0)
+		op := &text.DrawOptions{}
+		op.GeoM.Translate(button.centre.X-width/2, button.centre.Y-height/2)
+		op.ColorScale.ScaleWithColor(touchOutline)
+		text.Draw(screen, button.label, fonts.AsteroidsFace32, op)
+	}
+}
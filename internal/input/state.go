@@ -0,0 +1,26 @@
+package input
+
+// State holds the actions held down on this tick and the previous one, so
+// that both continuous (thrust) and edge-triggered (pause) actions can be
+// answered.
+type State struct {
+	current  Actions
+	previous Actions
+}
+
+func (s *State) Update(current Actions) {
+	s.previous = s.current
+	s.current = current
+}
+
+func (s State) Current() Actions {
+	return s.current
+}
+
+func (s State) IsPressed(action Action) bool {
+	return s.current.Has(action)
+}
+
+func (s State) IsJustPressed(action Action) bool {
+	return s.current.Has(action) && !s.previous.Has(action)
+}
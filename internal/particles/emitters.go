@@ -0,0 +1,116 @@
+package particles
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+var (
+	debrisColour  = color.NRGBA{R: 0xc0, G: 0xe8, B: 0xff, A: 0xff}
+	hullColour    = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	exhaustColour = color.NRGBA{R: 0xff, G: 0xc0, B: 0x40, A: 0xff}
+	embersColour  = color.NRGBA{R: 0xc0, G: 0x30, B: 0x00, A: 0x00}
+	sparkColour   = color.NRGBA{R: 0xff, G: 0xff, B: 0xe0, A: 0xff}
+	fizzleColour  = color.NRGBA{R: 0xff, G: 0xd0, B: 0x40, A: 0x00}
+)
+
+// AsteroidDebris scatters dust from where an asteroid broke up: the bigger
+// the asteroid, the more there is.
+func (s *System) AsteroidDebris(position geometry.Vector, velocity geometry.Vector, radius float64) {
+	for range int(radius / 2) {
+		direction := s.between(0, 2*math.Pi)
+		offset := geometry.VectorFrom(direction, s.between(0, radius/2))
+		drift := geometry.VectorFrom(direction, s.between(0.5, 3))
+
+		s.Emit(Particle{
+			Position: geometry.Vector{X: position.X + offset.X, Y: position.Y + offset.Y},
+			Velocity: geometry.Vector{X: velocity.X/2 + drift.X, Y: velocity.Y/2 + drift.Y},
+			Radius:   s.between(1, 2.5),
+			Drag:     0.02,
+			From:     debrisColour,
+			To:       transparent(debrisColour),
+			Fade:     EaseIn,
+		}, s.lifetime(500*time.Millisecond, 1200*time.Millisecond))
+	}
+}
+
+// ShipExplosion breaks a ship up into the edges of its outline, which fly
+// apart spinning. The hull is relative to position, as from Sprite.Hull;
+// without one, the ship breaks into a handful of random splinters.
+func (s *System) ShipExplosion(position geometry.Vector, velocity geometry.Vector, hull []geometry.Vector) {
+	if hull == nil {
+		hull = make([]geometry.Vector, 6)
+		for i := range hull {
+			hull[i] = *geometry.VectorFrom(float64(i)*math.Pi/3, s.between(10, 25))
+		}
+	}
+
+	for i, from := range hull {
+		to := hull[(i+1)%len(hull)]
+		midpoint := geometry.Vector{X: (from.X + to.X) / 2, Y: (from.Y + to.Y) / 2}
+		outwards := geometry.VectorFrom(math.Atan2(midpoint.Y, midpoint.X)+s.between(-0.3, 0.3), s.between(0.3, 1.5))
+
+		s.Emit(Particle{
+			Position: geometry.Vector{X: position.X + midpoint.X, Y: position.Y + midpoint.Y},
+			Velocity: geometry.Vector{X: velocity.X/2 + outwards.X, Y: velocity.Y/2 + outwards.Y},
+			Length:   from.DistanceFrom(&to),
+			Angle:    math.Atan2(to.Y-from.Y, to.X-from.X),
+			Spin:     s.between(-0.1, 0.1),
+			Drag:     0.005,
+			From:     hullColour,
+			To:       transparent(hullColour),
+			Fade:     EaseIn,
+		}, s.lifetime(1500*time.Millisecond, 2500*time.Millisecond))
+	}
+}
+
+// Exhaust puffs out of the back of a thrusting ship, heading in the given
+// direction.
+func (s *System) Exhaust(position geometry.Vector, velocity geometry.Vector, direction float64) {
+	for range 2 {
+		blast := geometry.VectorFrom(direction+s.between(-0.3, 0.3), s.between(2, 4))
+
+		s.Emit(Particle{
+			Position: position,
+			Velocity: geometry.Vector{X: velocity.X + blast.X, Y: velocity.Y + blast.Y},
+			Radius:   s.between(1.5, 3),
+			Drag:     0.05,
+			From:     exhaustColour,
+			To:       embersColour,
+			Fade:     EaseOut,
+		}, s.lifetime(150*time.Millisecond, 300*time.Millisecond))
+	}
+}
+
+// Sparks streak back from where a bullet travelling in the given direction
+// hit something.
+func (s *System) Sparks(position geometry.Vector, direction float64) {
+	for range 6 {
+		angle := direction + math.Pi + s.between(-1, 1)
+
+		s.Emit(Particle{
+			Position: position,
+			Velocity: *geometry.VectorFrom(angle, s.between(2, 5)),
+			Length:   4,
+			Angle:    angle,
+			Radius:   1,
+			Drag:     0.1,
+			From:     sparkColour,
+			To:       fizzleColour,
+			Fade:     Linear,
+		}, s.lifetime(100*time.Millisecond, 300*time.Millisecond))
+	}
+}
+
+func (s *System) lifetime(min, max time.Duration) int {
+	return internal.Ticks(time.Duration(s.between(float64(min), float64(max))))
+}
+
+func transparent(c color.NRGBA) color.NRGBA {
+	c.A = 0
+	return c
+}
@@ -0,0 +1,86 @@
+package particles
+
+import (
+	"image/color"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+// Curve shapes how something changes over a particle's lifetime: it maps
+// how far through its life the particle is (0..1) to how far through the
+// change it should be (0..1).
+type Curve func(t float64) float64
+
+func Linear(t float64) float64 {
+	return t
+}
+
+// EaseIn starts slowly and finishes quickly.
+func EaseIn(t float64) float64 {
+	return t * t
+}
+
+// EaseOut starts quickly and finishes slowly.
+func EaseOut(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// Particle is either a dot, or, if it has a Length, a line segment centred
+// on its Position that spins as it goes.
+type Particle struct {
+	Position geometry.Vector
+	Velocity geometry.Vector
+	Radius   float64
+	Length   float64
+	Angle    float64
+	Spin     float64
+	Drag     float64
+	From, To color.NRGBA
+	Fade     Curve
+	age      int
+	lifetime int
+}
+
+// Colour is the particle's colour at its current age: blended from From to
+// To, following its Fade curve.
+func (p *Particle) Colour() color.NRGBA {
+	t := p.Fade(float64(p.age) / float64(p.lifetime))
+	return color.NRGBA{
+		R: lerp(p.From.R, p.To.R, t),
+		G: lerp(p.From.G, p.To.G, t),
+		B: lerp(p.From.B, p.To.B, t),
+		A: lerp(p.From.A, p.To.A, t),
+	}
+}
+
+func (p *Particle) update(screenBounds *geometry.Dimension) {
+	p.age++
+	p.Position.Add(&p.Velocity)
+	p.Position = wrap(p.Position, screenBounds)
+	p.Velocity.Scale(1 - p.Drag)
+	p.Angle += p.Spin
+}
+
+func (p *Particle) isDead() bool {
+	return p.age >= p.lifetime
+}
+
+func lerp(from, to uint8, t float64) uint8 {
+	return uint8(float64(from) + (float64(to)-float64(from))*t)
+}
+
+func wrap(v geometry.Vector, screenBounds *geometry.Dimension) geometry.Vector {
+	for v.X < 0 {
+		v.X += screenBounds.W
+	}
+	for v.X >= screenBounds.W {
+		v.X -= screenBounds.W
+	}
+	for v.Y < 0 {
+		v.Y += screenBounds.H
+	}
+	for v.Y >= screenBounds.H {
+		v.Y -= screenBounds.H
+	}
+	return v
+}
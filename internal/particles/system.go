@@ -0,0 +1,73 @@
+package particles
+
+import (
+	"math/rand"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+// Enough for god mode on a busy level; anything emitted beyond this is
+// simply dropped.
+const maxParticles = 2048
+
+// System owns a fixed pool of particles, reusing the slots of those that
+// have died rather than allocating new ones. It has its own random source,
+// so purely cosmetic effects never change how a game plays out.
+type System struct {
+	screenBounds *geometry.Dimension
+	rand         *rand.Rand
+	pool         []Particle
+	alive        int
+}
+
+func NewSystem(screenBounds *geometry.Dimension, seed int64) *System {
+	return &System{
+		screenBounds: screenBounds,
+		rand:         rand.New(rand.NewSource(seed)),
+		pool:         make([]Particle, maxParticles),
+	}
+}
+
+func (s *System) Reset(seed int64) {
+	s.rand.Seed(seed)
+	s.alive = 0
+}
+
+// Emit adds a particle that lives for the given number of ticks.
+func (s *System) Emit(p Particle, lifetime int) {
+	if s.alive == len(s.pool) || lifetime <= 0 {
+		return
+	}
+	if p.Fade == nil {
+		p.Fade = Linear
+	}
+	p.age = 0
+	p.lifetime = lifetime
+	s.pool[s.alive] = p
+	s.alive++
+}
+
+// Update ages every particle, moving the last live one into the slot of any
+// that die, so the live particles are always at the front of the pool.
+func (s *System) Update() {
+	for i := 0; i < s.alive; {
+		s.pool[i].update(s.screenBounds)
+		if s.pool[i].isDead() {
+			s.alive--
+			s.pool[i] = s.pool[s.alive]
+			continue
+		}
+		i++
+	}
+}
+
+func (s *System) Particles(callback func(p *Particle)) {
+	for i := 0; i < s.alive; i++ {
+		callback(&s.pool[i])
+	}
+}
+
+// between picks a random number in [min, max).
+func (s *System) between(min, max float64) float64 {
+	return min + s.rand.Float64()*(max-min)
+}
@@ -0,0 +1,34 @@
+package render
+
+import (
+	"math"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/particles"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+func Particles(dst *ebiten.Image, system *particles.System, blend float64) {
+	system.Particles(func(p *particles.Particle) {
+		// Wind back to where the particle was part way through the last tick.
+		position := geometry.Vector{
+			X: p.Position.X - p.Velocity.X*(1-blend),
+			Y: p.Position.Y - p.Velocity.Y*(1-blend),
+		}
+		colour := p.Colour()
+
+		if p.Length == 0 {
+			vector.FillCircle(dst, float32(position.X), float32(position.Y), float32(p.Radius), colour, true)
+			return
+		}
+
+		angle := p.Angle - p.Spin*(1-blend)
+		half := geometry.VectorFrom(angle, p.Length/2)
+		vector.StrokeLine(dst,
+			float32(position.X-half.X), float32(position.Y-half.Y),
+			float32(position.X+half.X), float32(position.Y+half.Y),
+			float32(math.Max(p.Radius, 1.5)), colour, true)
+	})
+}
@@ -0,0 +1,55 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sprites"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	Bitmap = "bitmap"
+	Vector = "vector"
+)
+
+var Styles = []string{Bitmap, Vector}
+
+// Config picks how the game is drawn. Glow and Persistence (both 0..1) only
+// apply to the vector style: how much the lines bloom, and how much of each
+// frame is still showing on the next, like the phosphor of a vector monitor.
+type Config struct {
+	Style       string
+	Glow        float64
+	Persistence float64
+}
+
+var DefaultConfig = Config{
+	Style:       Bitmap,
+	Glow:        0.6,
+	Persistence: 0.5,
+}
+
+// style draws sprites in a particular look. Everything in the playfield is
+// drawn onto the image returned by begin, which end then puts on screen.
+type style interface {
+	begin(screen *ebiten.Image) *ebiten.Image
+	sprite(dst *ebiten.Image, s *sprites.Sprite, screenBounds *geometry.Dimension, alpha float64, blend float64)
+	end(screen *ebiten.Image)
+}
+
+type Renderer struct {
+	style style
+}
+
+func NewRenderer(config Config) (*Renderer, error) {
+	switch config.Style {
+	case Bitmap:
+		return &Renderer{style: &bitmapStyle{}}, nil
+	case Vector:
+		return &Renderer{style: newVectorStyle(config.Glow, config.Persistence)}, nil
+	default:
+		return nil, fmt.Errorf("unknown style %q, expected one of %v", config.Style, Styles)
+	}
+}
@@ -0,0 +1,82 @@
+package render
+
+import (
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sprites"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/colorm"
+)
+
+var (
+	sheetImage  *ebiten.Image
+	sheetAtlas  *sprites.Atlas
+	frameImages = make(map[*sprites.Frame]*ebiten.Image)
+)
+
+// spriteSheet is the sheet of the atlas currently in use, uploaded to the
+// GPU the first time it is needed.
+func spriteSheet() *ebiten.Image {
+	if atlas := sprites.Current(); atlas != sheetAtlas {
+		sheetImage = ebiten.NewImageFromImage(atlas.Sheet)
+		sheetAtlas = atlas
+		clear(frameImages)
+	}
+	return sheetImage
+}
+
+func frameImage(frame *sprites.Frame) *ebiten.Image {
+	sheet := spriteSheet()
+	if img, ok := frameImages[frame]; ok {
+		return img
+	}
+
+	img := ebiten.NewImageFromImage(sheet.SubImage(frame.Rect))
+	frameImages[frame] = img
+	return img
+}
+
+// bitmapStyle draws sprites straight from the sprite sheet.
+type bitmapStyle struct{}
+
+func (b *bitmapStyle) begin(screen *ebiten.Image) *ebiten.Image {
+	return screen
+}
+
+func (b *bitmapStyle) end(screen *ebiten.Image) {}
+
+// sprite draws s blend (0..1) of the way between where it was at the last
+// two ticks.
+func (b *bitmapStyle) sprite(screen *ebiten.Image, s *sprites.Sprite, screenBounds *geometry.Dimension, alpha float64, blend float64) {
+	img := frameImage(s.Frame)
+	position, orientation := s.Interpolate(blend)
+
+	var colorModel colorm.ColorM
+	colorModel.Scale(1.0, 1.0, 1.0, alpha)
+
+	op := &colorm.DrawImageOptions{}
+	if s.Frame.Scale != 1 {
+		op.Filter = ebiten.FilterLinear
+		op.GeoM.Scale(s.Frame.Scale, s.Frame.Scale)
+	}
+	op.GeoM.Translate(-s.Centre.X, -s.Centre.Y)
+	op.GeoM.Rotate(orientation)
+	op.GeoM.Translate(s.Centre.X, s.Centre.Y)
+
+	op.GeoM.Translate(position.X, position.Y)
+	colorm.DrawImage(screen, img, colorModel, op)
+
+	if s.Wraparound() {
+		op.GeoM.Translate(screenBounds.W, 0)
+		colorm.DrawImage(screen, img, colorModel, op)
+
+		op.GeoM.Translate(-screenBounds.W, +screenBounds.H)
+		colorm.DrawImage(screen, img, colorModel, op)
+
+		op.GeoM.Translate(-screenBounds.W, -screenBounds.H)
+		colorm.DrawImage(screen, img, colorModel, op)
+
+		op.GeoM.Translate(+screenBounds.W, -screenBounds.H)
+		colorm.DrawImage(screen, img, colorModel, op)
+	}
+}
@@ -0,0 +1,208 @@
+package render
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sprites"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	lineWidth    = 2
+	bulletRadius = 2.5
+	// The glow is a blurred copy of the lines, made by shrinking them down
+	// by this factor and stretching them back up again.
+	glowDownscale = 4
+)
+
+var phosphor = color.NRGBA{R: 0xe0, G: 0xf0, B: 0xff, A: 0xff}
+
+// polyline is a run of points, in units of the sprite's radius with the
+// sprite's centre at the origin and pointing along the X axis.
+type polyline struct {
+	points []geometry.Vector
+	closed bool
+}
+
+type outline struct {
+	lines  []polyline
+	radius float64
+}
+
+// After the original arcade machine's shapes.
+var (
+	shipLines = polyline{closed: true, points: []geometry.Vector{
+		{X: 1, Y: 0}, {X: -0.8, Y: 0.6}, {X: -0.5, Y: 0}, {X: -0.8, Y: -0.6},
+	}}
+	flameLines = polyline{points: []geometry.Vector{
+		{X: -0.6, Y: 0.25}, {X: -1.1, Y: 0}, {X: -0.6, Y: -0.25},
+	}}
+	saucerLines = []polyline{
+		{closed: true, points: []geometry.Vector{
+			{X: -1, Y: 0.1}, {X: -0.4, Y: 0.45}, {X: 0.4, Y: 0.45}, {X: 1, Y: 0.1}, {X: 0.4, Y: -0.25}, {X: -0.4, Y: -0.25},
+		}},
+		{points: []geometry.Vector{{X: -1, Y: 0.1}, {X: 1, Y: 0.1}}},
+		{points: []geometry.Vector{{X: -0.4, Y: -0.25}, {X: -0.25, Y: -0.6}, {X: 0.25, Y: -0.6}, {X: 0.4, Y: -0.25}}},
+	}
+	rockLines = []polyline{
+		{closed: true, points: []geometry.Vector{
+			{X: -0.4, Y: -1}, {X: 0.4, Y: -1}, {X: 1, Y: -0.4}, {X: 1, Y: 0.3}, {X: 0.3, Y: 1}, {X: -0.2, Y: 1},
+			{X: -0.2, Y: 0.3}, {X: -0.7, Y: 1}, {X: -1, Y: 0.3}, {X: -0.6, Y: 0}, {X: -1, Y: -0.4},
+		}},
+		{closed: true, points: []geometry.Vector{
+			{X: -0.5, Y: -1}, {X: 0, Y: -0.6}, {X: 0.5, Y: -1}, {X: 1, Y: -0.5}, {X: 0.6, Y: -0.1}, {X: 1, Y: 0.4},
+			{X: 0.4, Y: 1}, {X: -0.4, Y: 0.8}, {X: -0.6, Y: 1}, {X: -1, Y: 0.5}, {X: -0.8, Y: 0}, {X: -1, Y: -0.5},
+		}},
+		{closed: true, points: []geometry.Vector{
+			{X: -0.3, Y: -1}, {X: 0.6, Y: -1}, {X: 1, Y: -0.3}, {X: 0.6, Y: 0.1}, {X: 1, Y: 0.6}, {X: 0.4, Y: 1},
+			{X: -0.5, Y: 1}, {X: -1, Y: 0.4}, {X: -1, Y: -0.4}, {X: -0.5, Y: -0.5},
+		}},
+	}
+)
+
+// The lines to draw each frame with, by the frame's name in the atlas.
+var shapes = map[string][]polyline{
+	"ship":              {shipLines},
+	"ship-thrust":       {shipLines, flameLines},
+	"alien":             saucerLines,
+	"small-alien":       saucerLines,
+	"large-asteroid-1":  {rockLines[0]},
+	"large-asteroid-2":  {rockLines[1]},
+	"large-asteroid-3":  {rockLines[2]},
+	"medium-asteroid-1": {rockLines[0]},
+	"medium-asteroid-2": {rockLines[1]},
+	"medium-asteroid-3": {rockLines[2]},
+	"small-asteroid-1":  {rockLines[0]},
+	"small-asteroid-2":  {rockLines[1]},
+	"small-asteroid-3":  {rockLines[2]},
+}
+
+var outlines = make(map[*sprites.Frame]outline)
+
+// outlineOf sizes the frame's lines to match how big it is for collisions.
+func outlineOf(frame *sprites.Frame) (outline, bool) {
+	if o, ok := outlines[frame]; ok {
+		return o, true
+	}
+
+	lines, ok := shapes[frame.Name]
+	if !ok {
+		return outline{}, false
+	}
+
+	centre := sprites.Centre(frame)
+	radius := math.Min(centre.X, centre.Y)
+	if frame.Hull != nil {
+		radius = geometry.Radius(geometry.Translate(frame.Hull, geometry.Vector{X: -centre.X, Y: -centre.Y}))
+	}
+
+	outlines[frame] = outline{lines: lines, radius: radius}
+	return outlines[frame], true
+}
+
+// vectorStyle draws everything as glowing lines, in the manner of the
+// original arcade machine's vector monitor. Lines are drawn onto an image
+// which keeps a fading trace of the previous frames, before being put on
+// screen along with a blurred copy of themselves for the glow.
+type vectorStyle struct {
+	glow        float64
+	persistence float64
+	current     *ebiten.Image
+	previous    *ebiten.Image
+	bloom       *ebiten.Image
+	path        vector.Path
+}
+
+func newVectorStyle(glow float64, persistence float64) *vectorStyle {
+	return &vectorStyle{
+		glow:        glow,
+		persistence: persistence,
+	}
+}
+
+func (v *vectorStyle) begin(screen *ebiten.Image) *ebiten.Image {
+	bounds := screen.Bounds()
+	if v.current == nil || v.current.Bounds() != bounds {
+		v.current = ebiten.NewImage(bounds.Dx(), bounds.Dy())
+		v.previous = ebiten.NewImage(bounds.Dx(), bounds.Dy())
+		v.bloom = ebiten.NewImage(bounds.Dx()/glowDownscale, bounds.Dy()/glowDownscale)
+	}
+
+	v.current, v.previous = v.previous, v.current
+	v.current.Clear()
+
+	op := &ebiten.DrawImageOptions{}
+	op.ColorScale.ScaleAlpha(float32(v.persistence))
+	v.current.DrawImage(v.previous, op)
+
+	return v.current
+}
+
+func (v *vectorStyle) end(screen *ebiten.Image) {
+	screen.DrawImage(v.current, nil)
+	if v.glow <= 0 {
+		return
+	}
+
+	v.bloom.Clear()
+	op := &ebiten.DrawImageOptions{Filter: ebiten.FilterLinear}
+	op.GeoM.Scale(1.0/glowDownscale, 1.0/glowDownscale)
+	v.bloom.DrawImage(v.current, op)
+
+	op = &ebiten.DrawImageOptions{Filter: ebiten.FilterLinear, Blend: ebiten.BlendLighter}
+	op.GeoM.Scale(glowDownscale, glowDownscale)
+	op.ColorScale.ScaleAlpha(float32(v.glow))
+	screen.DrawImage(v.bloom, op)
+}
+
+func (v *vectorStyle) sprite(dst *ebiten.Image, s *sprites.Sprite, screenBounds *geometry.Dimension, alpha float64, blend float64) {
+	if alpha <= 0 {
+		return
+	}
+
+	position, orientation := s.Interpolate(blend)
+	centre := geometry.Add(&position, s.Centre)
+
+	v.path.Reset()
+	if outline, ok := outlineOf(s.Frame); ok {
+		v.addOutline(outline, centre, orientation)
+		if s.Wraparound() {
+			for _, offset := range []geometry.Vector{{X: screenBounds.W}, {Y: screenBounds.H}, {X: -screenBounds.W}, {Y: -screenBounds.H}} {
+				v.addOutline(outline, geometry.Add(centre, &offset), orientation)
+			}
+		}
+
+		op := &vector.DrawPathOptions{AntiAlias: true}
+		op.ColorScale.ScaleWithColor(phosphor)
+		op.ColorScale.ScaleAlpha(float32(alpha))
+		vector.StrokePath(dst, &v.path, &vector.StrokeOptions{Width: lineWidth, LineJoin: vector.LineJoinRound}, op)
+		return
+	}
+
+	// Anything without an outline, i.e. bullets, is just a dot.
+	colour := phosphor
+	colour.A = uint8(alpha * 0xff)
+	vector.FillCircle(dst, float32(centre.X), float32(centre.Y), bulletRadius, colour, true)
+}
+
+func (v *vectorStyle) addOutline(outline outline, centre *geometry.Vector, orientation float64) {
+	sin, cos := math.Sincos(orientation)
+	for _, line := range outline.lines {
+		for i, p := range line.points {
+			x := float32(centre.X + (p.X*cos-p.Y*sin)*outline.radius)
+			y := float32(centre.Y + (p.X*sin+p.Y*cos)*outline.radius)
+			if i == 0 {
+				v.path.MoveTo(x, y)
+			} else {
+				v.path.LineTo(x, y)
+			}
+		}
+		if line.closed {
+			v.path.Close()
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package render
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/rm-hull/asteroids/internal/entity"
+	"github.com/rm-hull/asteroids/internal/fonts"
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/sim"
+	"github.com/rm-hull/asteroids/internal/text_align"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// World draws everything in w, with sprites blend (0..1) of the way between
+// the last two ticks.
+func (r *Renderer) World(screen *ebiten.Image, w *sim.World, blend float64) {
+	screenBounds := w.ScreenBounds()
+
+	dst := r.style.begin(screen)
+	r.asteroids(dst, w, blend)
+	Particles(dst, w.Particles(), blend)
+	r.drawPlayer(dst, w.Player, screenBounds, blend)
+	r.drawAlien(dst, w.Alien, screenBounds, blend)
+	r.style.end(screen)
+
+	drawScores(screen, w.Player)
+	drawLevel(screen, w.Level, screenBounds)
+}
+
+// Asteroids draws just the asteroids, as a backdrop.
+func (r *Renderer) Asteroids(screen *ebiten.Image, w *sim.World, blend float64) {
+	dst := r.style.begin(screen)
+	r.asteroids(dst, w, blend)
+	r.style.end(screen)
+}
+
+func (r *Renderer) asteroids(dst *ebiten.Image, w *sim.World, blend float64) {
+	for _, asteroid := range w.Asteroids {
+		if !asteroid.IsExploded() {
+			r.style.sprite(dst, asteroid.Sprite(), w.ScreenBounds(), 1.0, blend)
+		}
+	}
+}
+
+func (r *Renderer) drawBullet(dst *ebiten.Image, bullet *entity.Bullet, screenBounds *geometry.Dimension, blend float64) {
+	if !bullet.IsExpired() {
+		r.style.sprite(dst, bullet.Sprite(), screenBounds, bullet.Alpha(), blend)
+	}
+}
+
+func drawScores(screen *ebiten.Image, p *entity.Player) {
+	op := &text.DrawOptions{}
+	op.ColorScale.ScaleWithColor(color.White)
+
+	op.GeoM.Translate(0, 30)
+	text.Draw(screen, fmt.Sprintf("LIVES: %d", p.LivesLeft()), fonts.AsteroidsFace32, op)
+
+	op.GeoM.Translate(400, 0)
+	text.Draw(screen, fmt.Sprintf("SCORE: %s", p.Score()), fonts.AsteroidsFace32, op)
+
+	op.GeoM.Translate(400, 0)
+	text.Draw(screen, fmt.Sprintf("FPS: %0.2f", ebiten.ActualFPS()), fonts.AsteroidsFace32, op)
+}
+
+func (r *Renderer) drawPlayer(dst *ebiten.Image, p *entity.Player, screenBounds *geometry.Dimension, blend float64) {
+	if p.IsGameOver() {
+		return
+	}
+
+	p.Bullets(func(bullet *entity.Bullet) {
+		r.drawBullet(dst, bullet, screenBounds, blend)
+	})
+
+	r.style.sprite(dst, p.Sprite(), screenBounds, p.Alpha(), blend)
+}
+
+func (r *Renderer) drawAlien(dst *ebiten.Image, a *entity.Alien, screenBounds *geometry.Dimension, blend float64) {
+	a.Bullets(func(bullet *entity.Bullet) {
+		r.drawBullet(dst, bullet, screenBounds, blend)
+	})
+
+	if a.IsVisible() {
+		r.style.sprite(dst, a.Sprite(), screenBounds, a.Alpha(), blend)
+	}
+}
+
+func drawLevel(screen *ebiten.Image, l *entity.Level, screenBounds *geometry.Dimension) {
+	if l.IsExpired() {
+		return
+	}
+
+	x, y := text_align.Center(screenBounds, l.Message(), fonts.AsteroidsFace64)
+	offset := l.Offset()
+
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(float64(x)+offset.X, float64(y)+offset.Y)
+	op.ColorScale.ScaleWithColor(color.White)
+
+	text.Draw(screen, l.Message(), fonts.AsteroidsFace64, op)
+}
@@ -0,0 +1,212 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/rm-hull/asteroids/internal/input"
+)
+
+var magic = [4]byte{'A', 'R', 'P', 'L'}
+
+const formatVersion = 2
+
+var ErrVersionMismatch = errors.New("replay was recorded with a different version of the game")
+
+var ErrThemeMismatch = errors.New("replay was recorded with a different theme")
+
+var errCorrupt = errors.New("corrupt replay file")
+
+// Limits on what is read from a file, so that a corrupt one can't ask for
+// an absurd amount of memory, or overflow an int.
+const (
+	maxStringLength = 256
+	maxTicks        = math.MaxInt32
+)
+
+// Header says what a replay was recorded with. The theme is part of it as
+// the sprites' hulls decide what collides with what.
+type Header struct {
+	GameVersion string
+	Theme       string
+	Seed        int64
+	Ticks       int
+}
+
+// run is a stretch of consecutive ticks with the same actions held; players
+// tend to hold the controls for a while, so this keeps replays small.
+type run struct {
+	actions input.Actions
+	count   int
+}
+
+type Replay struct {
+	Header
+	runs []run
+}
+
+func New(gameVersion, theme string, seed int64) *Replay {
+	return &Replay{
+		Header: Header{
+			GameVersion: gameVersion,
+			Theme:       theme,
+			Seed:        seed,
+		},
+	}
+}
+
+// Record appends the actions held for the next tick.
+func (r *Replay) Record(actions input.Actions) {
+	r.Ticks++
+	if n := len(r.runs); n > 0 && r.runs[n-1].actions == actions {
+		r.runs[n-1].count++
+		return
+	}
+	r.runs = append(r.runs, run{actions: actions, count: 1})
+}
+
+// Cursor plays a replay back tick by tick.
+type Cursor struct {
+	replay *Replay
+	run    int
+	offset int
+	tick   int
+}
+
+func (r *Replay) Cursor() *Cursor {
+	return &Cursor{replay: r}
+}
+
+func (c *Cursor) Next() (input.Actions, bool) {
+	runs := c.replay.runs
+	for c.run < len(runs) && c.offset >= runs[c.run].count {
+		c.run++
+		c.offset = 0
+	}
+	if c.run >= len(runs) {
+		return 0, false
+	}
+
+	c.offset++
+	c.tick++
+	return runs[c.run].actions, true
+}
+
+func (c *Cursor) Tick() int {
+	return c.tick
+}
+
+func (c *Cursor) IsFinished() bool {
+	return c.tick >= c.replay.Ticks
+}
+
+func (r *Replay) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	buf.Write(magic[:])
+	buf.Write(binary.LittleEndian.AppendUint16(nil, formatVersion))
+	buf.Write(binary.AppendUvarint(nil, uint64(len(r.GameVersion))))
+	buf.WriteString(r.GameVersion)
+	buf.Write(binary.AppendUvarint(nil, uint64(len(r.Theme))))
+	buf.WriteString(r.Theme)
+	buf.Write(binary.AppendVarint(nil, r.Seed))
+	buf.Write(binary.AppendUvarint(nil, uint64(r.Ticks)))
+
+	for _, run := range r.runs {
+		buf.Write(binary.AppendUvarint(nil, uint64(run.actions)))
+		buf.Write(binary.AppendUvarint(nil, uint64(run.count)))
+	}
+
+	return buf.WriteTo(w)
+}
+
+// Read decodes a replay, rejecting it if it wasn't recorded by the given
+// version of the game with the given theme, as any change to the simulation
+// would make it play out differently.
+func Read(r io.Reader, gameVersion, theme string) (*Replay, error) {
+	br := bufio.NewReader(r)
+
+	var header [4]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return nil, err
+	}
+	if header != magic {
+		return nil, errors.New("not a replay file")
+	}
+
+	var version uint16
+	if err := binary.Read(br, binary.LittleEndian, &version); err != nil {
+		return nil, err
+	}
+	if version != formatVersion {
+		return nil, fmt.Errorf("unsupported replay format version: %d", version)
+	}
+
+	recordedVersion, err := readString(br)
+	if err != nil {
+		return nil, err
+	}
+	if recordedVersion != gameVersion {
+		return nil, fmt.Errorf("%w: recorded with %q, this is %q", ErrVersionMismatch, recordedVersion, gameVersion)
+	}
+
+	recordedTheme, err := readString(br)
+	if err != nil {
+		return nil, err
+	}
+	if recordedTheme != theme {
+		return nil, fmt.Errorf("%w: recorded with %q, this is %q", ErrThemeMismatch, recordedTheme, theme)
+	}
+
+	seed, err := binary.ReadVarint(br)
+	if err != nil {
+		return nil, err
+	}
+
+	ticks, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	if ticks > maxTicks {
+		return nil, errCorrupt
+	}
+
+	replay := New(gameVersion, theme, seed)
+	for replay.Ticks < int(ticks) {
+		actions, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		count, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 || count > ticks-uint64(replay.Ticks) {
+			return nil, errCorrupt
+		}
+
+		replay.runs = append(replay.runs, run{actions: input.Actions(actions), count: int(count)})
+		replay.Ticks += int(count)
+	}
+
+	return replay, nil
+}
+
+func readString(br *bufio.Reader) (string, error) {
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return "", err
+	}
+	if n > maxStringLength {
+		return "", errCorrupt
+	}
+	s := make([]byte, n)
+	if _, err := io.ReadFull(br, s); err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
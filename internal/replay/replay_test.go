@@ -0,0 +1,126 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/rm-hull/asteroids/internal/input"
+)
+
+func TestRoundTrip(t *testing.T) {
+	fire := input.Actions(0).With(input.Fire)
+	thrustAndFire := fire.With(input.Thrust)
+
+	tests := []struct {
+		name    string
+		seed    int64
+		actions []input.Actions
+		runs    int
+	}{
+		{"empty", 1, nil, 0},
+		{"single tick", 2, []input.Actions{fire}, 1},
+		{"one long run", 3, slices.Repeat([]input.Actions{thrustAndFire}, 1000), 1},
+		{"alternating", -4, []input.Actions{0, fire, 0, fire, 0}, 5},
+		{"runs", 1 << 40, []input.Actions{0, 0, fire, fire, fire, thrustAndFire, 0}, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recording := New("v1", "classic", test.seed)
+			for _, actions := range test.actions {
+				recording.Record(actions)
+			}
+			if len(recording.runs) != test.runs {
+				t.Errorf("encoded as %d runs, want %d", len(recording.runs), test.runs)
+			}
+
+			var buf bytes.Buffer
+			if _, err := recording.WriteTo(&buf); err != nil {
+				t.Fatal(err)
+			}
+
+			decoded, err := Read(&buf, "v1", "classic")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decoded.Header != recording.Header {
+				t.Errorf("header = %+v, want %+v", decoded.Header, recording.Header)
+			}
+
+			var got []input.Actions
+			cursor := decoded.Cursor()
+			for actions, ok := cursor.Next(); ok; actions, ok = cursor.Next() {
+				got = append(got, actions)
+			}
+			if !slices.Equal(got, test.actions) {
+				t.Errorf("played back %v, want %v", got, test.actions)
+			}
+			if !cursor.IsFinished() || cursor.Tick() != len(test.actions) {
+				t.Errorf("cursor finished at tick %d, want %d", cursor.Tick(), len(test.actions))
+			}
+		})
+	}
+}
+
+func TestReadRejectsMismatches(t *testing.T) {
+	tests := []struct {
+		name               string
+		gameVersion, theme string
+		want               error
+	}{
+		{"other version", "v2", "classic", ErrVersionMismatch},
+		{"other theme", "v1", "neon", ErrThemeMismatch},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if _, err := New("v1", "classic", 1).WriteTo(&buf); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := Read(&buf, test.gameVersion, test.theme); !errors.Is(err, test.want) {
+				t.Errorf("err = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestReadRejectsCorruptFiles(t *testing.T) {
+	header := func(versionLength uint64) []byte {
+		buf := append([]byte{}, magic[:]...)
+		buf = binary.LittleEndian.AppendUint16(buf, formatVersion)
+		return binary.AppendUvarint(buf, versionLength)
+	}
+	valid := func(ticks uint64) []byte {
+		buf := append(header(2), "v1"...)
+		buf = append(binary.AppendUvarint(buf, 7), "classic"...)
+		buf = binary.AppendVarint(buf, 1)
+		return binary.AppendUvarint(buf, ticks)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"huge version length", header(math.MaxUint64)},
+		{"huge theme length", binary.AppendUvarint(append(header(2), "v1"...), math.MaxUint64)},
+		{"huge tick count", valid(math.MaxUint64)},
+		{"run longer than the replay", binary.AppendUvarint(binary.AppendUvarint(valid(10), 0), 11)},
+		{"huge run", binary.AppendUvarint(binary.AppendUvarint(valid(10), 0), math.MaxUint64)},
+		{"empty run", binary.AppendUvarint(binary.AppendUvarint(valid(10), 0), 0)},
+		{"truncated", valid(10)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Read(bytes.NewReader(test.data), "v1", "classic"); err == nil {
+				t.Error("read without error")
+			}
+		})
+	}
+}
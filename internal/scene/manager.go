@@ -0,0 +1,59 @@
+package scene
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type Scene interface {
+	Update() error
+	Draw(screen *ebiten.Image)
+}
+
+// Scenes which need to (re)initialise themselves each time they become
+// active can implement Enterer.
+type Enterer interface {
+	Enter()
+}
+
+type Manager struct {
+	current Scene
+	next    Scene
+}
+
+func NewManager(initial Scene) *Manager {
+	m := &Manager{}
+	m.activate(initial)
+	return m
+}
+
+// SwitchTo requests a transition to the given scene. The switch is deferred
+// until the current scene has finished its update, so a scene may safely
+// call it part way through handling a frame.
+func (m *Manager) SwitchTo(next Scene) {
+	m.next = next
+}
+
+func (m *Manager) Update() error {
+	if err := m.current.Update(); err != nil {
+		return err
+	}
+
+	if m.next != nil {
+		next := m.next
+		m.next = nil
+		m.activate(next)
+	}
+
+	return nil
+}
+
+func (m *Manager) Draw(screen *ebiten.Image) {
+	m.current.Draw(screen)
+}
+
+func (m *Manager) activate(s Scene) {
+	m.current = s
+	if enterer, ok := s.(Enterer); ok {
+		enterer.Enter()
+	}
+}
@@ -0,0 +1,71 @@
+package score
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type Config struct {
+	// The displayed score wraps back to zero on reaching this value, like
+	// the original cabinet's five digit display. Zero disables rollover.
+	Rollover int
+	// An extra life is awarded each time the (unwrapped) total passes a
+	// multiple of this value. Zero disables extra lives.
+	ExtraLifeEvery int
+	// Pad the formatted score with leading zeros to the width of the
+	// largest displayable value, e.g. 00420.
+	LeadingZeros bool
+}
+
+var DefaultConfig = Config{
+	Rollover:       100000,
+	ExtraLifeEvery: 10000,
+	LeadingZeros:   false,
+}
+
+type Score struct {
+	config Config
+	total  int
+}
+
+func New(config Config) *Score {
+	return &Score{config: config}
+}
+
+// Add accumulates the points and returns the number of extra lives earned
+// as a result. Thresholds are tracked against the unwrapped total, so they
+// keep working after the displayed score rolls over.
+func (s *Score) Add(points int) int {
+	previous := s.total
+	s.total += points
+
+	if s.config.ExtraLifeEvery <= 0 {
+		return 0
+	}
+	return s.total/s.config.ExtraLifeEvery - previous/s.config.ExtraLifeEvery
+}
+
+// Value is the score as the player sees it, i.e. after any rollover.
+func (s *Score) Value() int {
+	if s.config.Rollover <= 0 {
+		return s.total
+	}
+	return s.total % s.config.Rollover
+}
+
+func (s *Score) Total() int {
+	return s.total
+}
+
+func (s *Score) String() string {
+	return s.config.Format(s.Value())
+}
+
+func (c Config) Format(value int) string {
+	if !c.LeadingZeros || c.Rollover <= 0 {
+		return strconv.Itoa(value)
+	}
+
+	width := len(strconv.Itoa(c.Rollover - 1))
+	return fmt.Sprintf("%0*d", width, value)
+}
@@ -0,0 +1,256 @@
+package sfx
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/sound"
+	"github.com/rm-hull/asteroids/resources/soundfx"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+)
+
+const (
+	sampleRate = 44100
+	fadeOut    = 150 * time.Millisecond
+)
+
+// Bus is a category of sounds which share a volume control.
+type Bus int
+
+const (
+	SFX Bus = iota
+	Music
+	UI
+	numBuses
+)
+
+type sample struct {
+	data      []byte
+	volume    float64
+	bus       Bus
+	maxVoices int
+}
+
+var samples = map[sound.Effect]sample{
+	sound.PlayerFire:        {soundfx.LazerGunShot2, 0.5, SFX, 4},
+	sound.AlienFire:         {soundfx.LazerGunShot2, 0.5, SFX, 2},
+	sound.Thrust:            {soundfx.Thrust, 0.1, SFX, 1},
+	sound.PlayerExplosion:   {soundfx.Explosion1, 1.0, SFX, 1},
+	sound.AlienExplosion:    {soundfx.Explosion2, 0.15, SFX, 1},
+	sound.AsteroidExplosion: {soundfx.Explosion2, 0.15, SFX, 3},
+	sound.ExtraLife:         {soundfx.ExtraLife, 1.0, UI, 1},
+	sound.GameOver:          {soundfx.GameOver, 1.0, UI, 1},
+}
+
+// voices are the players for one sound, all sharing the same decoded
+// samples. At most maxVoices of them play at once; asking for more while
+// they are all busy is ignored, rather than piling up on top of each other.
+type voices struct {
+	sample
+	pcm     []byte
+	players []*audio.Player
+}
+
+// loop is a sound that repeats for as long as it is wanted, fading out once
+// it no longer is.
+type loop struct {
+	*voices
+	player *audio.Player
+	wanted bool
+	gain   float64
+}
+
+// Manager plays the simulation's sound effects through ebiten's audio
+// context. Each sound is decoded just the once, up front.
+type Manager struct {
+	context *audio.Context
+	sounds  map[sound.Effect]*voices
+	loops   map[sound.Effect]*loop
+	volumes [numBuses]float64
+	paused  bool
+}
+
+func NewManager() *Manager {
+	m := &Manager{
+		context: audio.NewContext(sampleRate),
+		sounds:  make(map[sound.Effect]*voices, len(samples)),
+		loops:   make(map[sound.Effect]*loop),
+		volumes: [numBuses]float64{1.0, 1.0, 1.0},
+	}
+
+	for effect, sample := range samples {
+		pcm, err := decode(sample.data)
+		if err != nil {
+			log.Printf("unable to load sound %d: %v", effect, err)
+			continue
+		}
+		m.sounds[effect] = &voices{sample: sample, pcm: pcm}
+	}
+	for effect, tone := range tones {
+		m.sounds[effect] = &voices{
+			sample: sample{volume: tone.volume, bus: tone.bus, maxVoices: 1},
+			pcm:    thump(tone.frequency),
+		}
+	}
+	return m
+}
+
+// decode converts a WAV file to the 16-bit stereo PCM that audio players
+// expect, at the audio context's sample rate.
+func decode(data []byte) ([]byte, error) {
+	stream, err := wav.DecodeWithSampleRate(sampleRate, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(stream)
+}
+
+func (m *Manager) Play(effect sound.Effect) {
+	v, ok := m.sounds[effect]
+	if !ok {
+		return
+	}
+
+	player := m.idleVoice(v)
+	if player == nil {
+		return
+	}
+
+	if err := player.Rewind(); err != nil {
+		log.Printf("unable to play sound %d: %v", effect, err)
+		return
+	}
+	player.SetVolume(v.volume * m.volumes[v.bus])
+	player.Play()
+}
+
+func (m *Manager) idleVoice(v *voices) *audio.Player {
+	for _, player := range v.players {
+		if !player.IsPlaying() {
+			return player
+		}
+	}
+
+	if len(v.players) < v.maxVoices {
+		player := m.context.NewPlayerFromBytes(v.pcm)
+		v.players = append(v.players, player)
+		return player
+	}
+	return nil
+}
+
+func (m *Manager) Loop(effect sound.Effect) {
+	l := m.loop(effect)
+	if l == nil {
+		return
+	}
+
+	l.wanted = true
+	l.gain = 1.0
+	l.player.SetVolume(l.volume * m.volumes[l.bus])
+	if !m.paused {
+		l.player.Play()
+	}
+}
+
+// Stop lets a looping sound fade out, over the next few calls to Update.
+func (m *Manager) Stop(effect sound.Effect) {
+	if l, ok := m.loops[effect]; ok {
+		l.wanted = false
+	}
+}
+
+func (m *Manager) loop(effect sound.Effect) *loop {
+	if l, ok := m.loops[effect]; ok {
+		return l
+	}
+
+	v, ok := m.sounds[effect]
+	if !ok {
+		return nil
+	}
+
+	player, err := m.context.NewPlayer(audio.NewInfiniteLoop(bytes.NewReader(v.pcm), int64(len(v.pcm))))
+	if err != nil {
+		log.Printf("unable to loop sound %d: %v", effect, err)
+		return nil
+	}
+
+	l := &loop{voices: v, player: player}
+	m.loops[effect] = l
+	return l
+}
+
+// Update fades out any loops which have been stopped, and should be called
+// once every tick.
+func (m *Manager) Update() {
+	if m.paused {
+		return
+	}
+
+	step := 1.0 / float64(internal.Ticks(fadeOut))
+	for _, l := range m.loops {
+		if l.wanted || !l.player.IsPlaying() {
+			continue
+		}
+
+		l.gain -= step
+		if l.gain <= 0 {
+			l.gain = 0
+			l.player.Pause()
+		}
+		l.player.SetVolume(l.volume * m.volumes[l.bus] * l.gain)
+	}
+}
+
+// Pause silences the loops, without forgetting which are going, for when
+// the game is paused.
+func (m *Manager) Pause() {
+	if m.paused {
+		return
+	}
+
+	m.paused = true
+	for _, l := range m.loops {
+		l.player.Pause()
+	}
+}
+
+// Resume picks up any loops that were going, or fading out, when paused.
+func (m *Manager) Resume() {
+	if !m.paused {
+		return
+	}
+
+	m.paused = false
+	for _, l := range m.loops {
+		if l.gain > 0 {
+			l.player.Play()
+		}
+	}
+}
+
+// SetVolume sets the volume of everything on the bus, including any sounds
+// already playing. It is clamped to 0..1, as ebiten won't accept any other.
+func (m *Manager) SetVolume(bus Bus, volume float64) {
+	volume = max(0, min(volume, 1))
+	m.volumes[bus] = volume
+	for _, v := range m.sounds {
+		if v.bus != bus {
+			continue
+		}
+		for _, player := range v.players {
+			player.SetVolume(v.volume * volume)
+		}
+	}
+	for _, l := range m.loops {
+		if l.bus == bus {
+			l.player.SetVolume(l.volume * volume * l.gain)
+		}
+	}
+}
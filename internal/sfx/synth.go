@@ -0,0 +1,45 @@
+package sfx
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/rm-hull/asteroids/internal/sound"
+)
+
+const thumpDuration = 120 * time.Millisecond
+
+type tone struct {
+	frequency float64
+	volume    float64
+	bus       Bus
+}
+
+// The heartbeat's two notes are a whole tone (two semitones) apart, low enough
+// to be felt as much as heard.
+var tones = map[sound.Effect]tone{
+	sound.BeatLow:  {55, 0.6, Music},
+	sound.BeatHigh: {62, 0.6, Music},
+}
+
+// thump synthesises a short, decaying sine wave as 16-bit stereo PCM. Its
+// pitch drops quickly at the start to give it some punch.
+func thump(frequency float64) []byte {
+	samples := int(thumpDuration.Seconds() * sampleRate)
+	pcm := make([]byte, samples*4)
+
+	phase := 0.0
+	for i := range samples {
+		t := float64(i) / sampleRate
+		attack := math.Min(1, t/0.005)
+		envelope := attack * math.Exp(-t/0.04)
+
+		phase += 2 * math.Pi * frequency * (1 + 0.5*math.Exp(-t/0.01)) / sampleRate
+		value := int16(envelope * math.Sin(phase) * math.MaxInt16)
+
+		binary.LittleEndian.PutUint16(pcm[i*4:], uint16(value))
+		binary.LittleEndian.PutUint16(pcm[i*4+2:], uint16(value))
+	}
+	return pcm
+}
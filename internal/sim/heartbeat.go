@@ -0,0 +1,57 @@
+package sim
+
+import (
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/sound"
+)
+
+const (
+	slowestBeat = 1000 * time.Millisecond
+	fastestBeat = 250 * time.Millisecond
+)
+
+// heartbeat is the two-tone thump of the original arcade game, which beats
+// faster the fewer asteroids are left out of the most there have been on
+// this level. It rests while the level is being announced and while the
+// ship is blowing up.
+type heartbeat struct {
+	ticks int
+	high  bool
+	peak  int
+}
+
+func (h *heartbeat) reset() {
+	h.ticks = 0
+	h.high = false
+	h.peak = 0
+}
+
+func (h *heartbeat) update(w *World, sounds sound.Sink) {
+	remaining := len(w.Asteroids)
+	h.peak = max(h.peak, remaining)
+
+	if !w.Level.IsExpired() || w.Player.IsDying() || w.Player.IsGameOver() || remaining == 0 {
+		h.ticks = 0
+		return
+	}
+
+	h.ticks++
+	if h.ticks < h.interval(remaining) {
+		return
+	}
+
+	h.ticks = 0
+	if h.high {
+		sounds.Play(sound.BeatHigh)
+	} else {
+		sounds.Play(sound.BeatLow)
+	}
+	h.high = !h.high
+}
+
+func (h *heartbeat) interval(remaining int) int {
+	slowest, fastest := internal.Ticks(slowestBeat), internal.Ticks(fastestBeat)
+	return fastest + (slowest-fastest)*remaining/h.peak
+}
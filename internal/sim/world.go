@@ -0,0 +1,247 @@
+package sim
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/entity"
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/particles"
+	"github.com/rm-hull/asteroids/internal/sound"
+)
+
+const (
+	initialAsteroids = 6
+	attractAsteroids = 8
+
+	// Comfortably bigger than the largest asteroid, so most things are only
+	// filed under a handful of cells.
+	collisionCellSize = 128
+)
+
+// World is the game itself, free of any rendering, audio or device input,
+// and advanced one tick at a time with Step. It can be driven by the ebiten
+// front-end, or stepped directly from tests and batch tools.
+type World struct {
+	Player    *entity.Player
+	Alien     *entity.Alien
+	Asteroids map[int]*entity.Asteroid
+	Level     *entity.Level
+	Sequence  *internal.Sequence
+	Seed      int64
+	Ticks     int
+	frames    int
+	controls  input.State
+	env       *entity.Env
+	nearby    *entity.SpatialHash
+	heartbeat heartbeat
+}
+
+func NewWorld(screenBounds *geometry.Dimension, sounds sound.Sink, rules entity.Rules, seed int64) *World {
+	w := &World{
+		Sequence: internal.NewSequence(),
+		Level:    entity.NewLevel(),
+		nearby:   entity.NewSpatialHash(screenBounds, collisionCellSize),
+		env: &entity.Env{
+			ScreenBounds: screenBounds,
+			Rules:        rules,
+			Sounds:       sounds,
+			Particles:    particles.NewSystem(screenBounds, seed),
+		},
+	}
+	w.Reset(seed)
+	return w
+}
+
+// Reset starts a new game. All gameplay randomness is drawn from a single
+// source seeded here, so replaying the same seed and inputs reproduces the
+// same game.
+func (w *World) Reset(seed int64) {
+	w.Seed = seed
+	w.Ticks = 0
+	w.controls = input.State{}
+	w.env.Rand = rand.New(rand.NewSource(seed))
+	w.env.Particles.Reset(seed)
+	w.heartbeat.reset()
+
+	w.Level.Reset(1)
+	w.Player = entity.NewPlayer(w.env)
+	w.Alien = entity.NewAlien(1, w.Player.NotNear(), w.Player, w.env)
+	w.Asteroids = entity.NewAsteroidBelt(initialAsteroids, w.Sequence, w.Player, w.env)
+}
+
+func (w *World) NextLevel() {
+	w.Level.Next()
+	w.Player.Prepare()
+	w.Alien = entity.NewAlien(w.Level.Current(), w.Player.NotNear(), w.Player, w.env)
+	w.Asteroids = entity.NewAsteroidBelt(5+w.Level.Current(), w.Sequence, w.Player, w.env)
+	w.heartbeat.reset()
+}
+
+// Attract replaces the asteroid belt with a fresh one to drift around
+// behind the title screen.
+func (w *World) Attract() {
+	w.Asteroids = entity.NewAsteroidBelt(attractAsteroids, w.Sequence, w.Player, w.env)
+}
+
+func (w *World) ScreenBounds() *geometry.Dimension {
+	return w.env.ScreenBounds
+}
+
+func (w *World) IsGameOver() bool {
+	return w.Player.IsGameOver()
+}
+
+// Step advances the game by a single tick with the given actions held. The
+// actions are the only input to the simulation, so recording them along with
+// the seed is enough to replay a game exactly.
+func (w *World) Step(actions input.Actions) error {
+	w.Ticks++
+	w.frames++
+	w.controls.Update(actions)
+
+	for _, idx := range slices.Sorted(maps.Keys(w.Asteroids)) {
+		asteroid := w.Asteroids[idx]
+		err := asteroid.Update()
+		if err != nil {
+			return err
+		}
+
+		if asteroid.IsExploded() {
+			if !asteroid.IsSmashed() {
+				w.Player.UpdateScore(asteroid.Value())
+			}
+			delete(w.Asteroids, idx)
+		}
+	}
+
+	err := w.Player.Update(w.controls)
+	if err != nil {
+		return err
+	}
+
+	w.HandleCollisionDetection()
+
+	err = w.Alien.Update()
+	if err != nil {
+		return err
+	}
+
+	err = w.Level.Update()
+	if err != nil {
+		return err
+	}
+
+	if len(w.Asteroids) == 0 {
+		w.NextLevel()
+	}
+
+	w.heartbeat.update(w, w.env.Sounds)
+	w.env.Particles.Update()
+	return nil
+}
+
+// Drift moves the asteroids along without any of the game rules applying,
+// for the backdrop to the title and game over screens.
+func (w *World) Drift() error {
+	w.frames++
+	for _, asteroid := range w.Asteroids {
+		if err := asteroid.Update(); err != nil {
+			return err
+		}
+	}
+	w.env.Particles.Update()
+	return nil
+}
+
+// Frames counts every tick the world has moved, whether in play or drifting.
+func (w *World) Frames() int {
+	return w.frames
+}
+
+// HandleCollisionDetection only checks things for collisions if the spatial
+// hash has them near each other. Candidates are still checked in index
+// order, so the outcome is exactly as if every pair had been checked.
+func (w *World) HandleCollisionDetection() {
+	w.nearby.Clear()
+	for _, idx := range slices.Sorted(maps.Keys(w.Asteroids)) {
+		w.nearby.Insert(idx, w.Asteroids[idx])
+	}
+
+	w.Player.Bullets(func(bullet *entity.Bullet) {
+		for _, idx := range w.nearby.Near(bullet) {
+			asteroid := w.Asteroids[idx]
+			if !asteroid.IsExploded() && bullet.CollisionDetected(asteroid) {
+				w.sparks(bullet)
+				for _, fragment := range asteroid.Explode() {
+					w.Asteroids[w.Sequence.GetNext()] = fragment
+				}
+				return
+			}
+		}
+
+		if w.Alien.IsAlive() && bullet.CollisionDetected(w.Alien) {
+			w.sparks(bullet)
+			w.Alien.Kill()
+			w.Player.UpdateScore(w.Alien.Value())
+		}
+	})
+
+	// The saucer and its bullets break up asteroids just as the player's do,
+	// but the player earns nothing from it.
+	w.Alien.Bullets(func(bullet *entity.Bullet) {
+		for _, idx := range w.nearby.Near(bullet) {
+			asteroid := w.Asteroids[idx]
+			if !asteroid.IsExploded() && bullet.CollisionDetected(asteroid) {
+				w.sparks(bullet)
+				w.smash(asteroid)
+				return
+			}
+		}
+
+		if w.Player.IsAlive() && bullet.CollisionDetected(w.Player) {
+			w.sparks(bullet)
+			w.Player.Kill()
+		}
+	})
+
+	for _, idx := range w.nearby.Near(w.Player) {
+		if w.Player.IsAlive() && entity.CollisionDetected(w.Asteroids[idx], w.Player, w.ScreenBounds()) {
+			w.Player.Kill()
+			break
+		}
+	}
+
+	if w.Player.IsAlive() && w.Alien.IsAlive() && entity.CollisionDetected(w.Alien, w.Player, w.ScreenBounds()) {
+		w.Player.Kill()
+	}
+
+	if w.Alien.IsAlive() {
+		for _, idx := range w.nearby.Near(w.Alien) {
+			asteroid := w.Asteroids[idx]
+			if !asteroid.IsExploded() && entity.CollisionDetected(asteroid, w.Alien, w.ScreenBounds()) {
+				w.smash(asteroid)
+				w.Alien.Kill()
+				break
+			}
+		}
+	}
+}
+
+func (w *World) smash(asteroid *entity.Asteroid) {
+	for _, fragment := range asteroid.Smash() {
+		w.Asteroids[w.Sequence.GetNext()] = fragment
+	}
+}
+
+func (w *World) sparks(bullet *entity.Bullet) {
+	w.env.Particles.Sparks(*bullet.Position(), bullet.Sprite().Direction)
+}
+
+// Particles are purely cosmetic, and have no bearing on the game.
+func (w *World) Particles() *particles.System {
+	return w.env.Particles
+}
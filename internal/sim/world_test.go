@@ -0,0 +1,176 @@
+package sim
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/entity"
+	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/replay"
+	"github.com/rm-hull/asteroids/internal/sound"
+)
+
+var screenSize = geometry.Dimension{W: 1024, H: 768}
+
+const maxTicks = 10 * 60 * internal.TicksPerSecond
+
+// mash holds down a random handful of controls, changing them every so often.
+func mash(rng *rand.Rand) func(tick int) input.Actions {
+	controls := []input.Action{input.RotateLeft, input.RotateRight, input.Thrust, input.Fire, input.Hyperspace}
+	var actions input.Actions
+
+	return func(tick int) input.Actions {
+		if tick%20 == 0 {
+			actions = 0
+			for _, action := range controls {
+				if rng.Intn(3) == 0 {
+					actions = actions.With(action)
+				}
+			}
+		}
+		return actions
+	}
+}
+
+func TestReplayingRecordedActionsIsDeterministic(t *testing.T) {
+	for _, seed := range []int64{1, 2, 3} {
+		recording := replay.New("test", "test", seed)
+		controls := mash(rand.New(rand.NewSource(seed)))
+
+		original := NewWorld(&screenSize, sound.Mute{}, entity.DefaultRules, seed)
+		for !original.IsGameOver() && original.Ticks < maxTicks {
+			actions := controls(original.Ticks)
+			recording.Record(actions)
+			if err := original.Step(actions); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		replayed := NewWorld(&screenSize, sound.Mute{}, entity.DefaultRules, recording.Seed)
+		cursor := recording.Cursor()
+		for actions, ok := cursor.Next(); ok; actions, ok = cursor.Next() {
+			if err := replayed.Step(actions); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if replayed.Ticks != original.Ticks {
+			t.Errorf("seed %d: replayed %d ticks, want %d", seed, replayed.Ticks, original.Ticks)
+		}
+		if got, want := replayed.Player.Score().Total(), original.Player.Score().Total(); got != want {
+			t.Errorf("seed %d: replayed score %d, want %d", seed, got, want)
+		}
+		if got, want := replayed.Level.Current(), original.Level.Current(); got != want {
+			t.Errorf("seed %d: replayed level %d, want %d", seed, got, want)
+		}
+		if replayed.IsGameOver() != original.IsGameOver() {
+			t.Errorf("seed %d: replayed game over %t, want %t", seed, replayed.IsGameOver(), original.IsGameOver())
+		}
+	}
+}
+
+// denseWorld is a crowded belt with plenty of bullets flying about, built up
+// in god mode, which is then switched off so the ship can be hit too.
+func denseWorld(b *testing.B) *World {
+	w := NewWorld(&screenSize, sound.Mute{}, entity.DefaultRules, 1)
+	w.Asteroids = entity.NewAsteroidBelt(150, w.Sequence, w.Player, w.env)
+
+	spray := input.Actions(0).With(input.RotateRight).With(input.Fire)
+	godMode := input.Actions(0).With(input.GodMode)
+	for tick := range 200 {
+		actions := spray
+		if tick == 0 || tick == 199 {
+			actions = actions | godMode
+		}
+		if err := w.Step(actions); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return w
+}
+
+// handleCollisionDetectionAllPairs is HandleCollisionDetection as it was
+// before the spatial hash, checking everything against every asteroid.
+func handleCollisionDetectionAllPairs(w *World) {
+	asteroidIdxs := slices.Sorted(maps.Keys(w.Asteroids))
+
+	w.Player.Bullets(func(bullet *entity.Bullet) {
+		for _, idx := range asteroidIdxs {
+			asteroid := w.Asteroids[idx]
+			if !asteroid.IsExploded() && bullet.CollisionDetected(asteroid) {
+				w.sparks(bullet)
+				for _, fragment := range asteroid.Explode() {
+					w.Asteroids[w.Sequence.GetNext()] = fragment
+				}
+				return
+			}
+		}
+
+		if w.Alien.IsAlive() && bullet.CollisionDetected(w.Alien) {
+			w.sparks(bullet)
+			w.Alien.Kill()
+			w.Player.UpdateScore(w.Alien.Value())
+		}
+	})
+
+	w.Alien.Bullets(func(bullet *entity.Bullet) {
+		for _, idx := range asteroidIdxs {
+			asteroid := w.Asteroids[idx]
+			if !asteroid.IsExploded() && bullet.CollisionDetected(asteroid) {
+				w.sparks(bullet)
+				w.smash(asteroid)
+				return
+			}
+		}
+
+		if w.Player.IsAlive() && bullet.CollisionDetected(w.Player) {
+			w.sparks(bullet)
+			w.Player.Kill()
+		}
+	})
+
+	for _, idx := range asteroidIdxs {
+		if w.Player.IsAlive() && entity.CollisionDetected(w.Asteroids[idx], w.Player, w.ScreenBounds()) {
+			w.Player.Kill()
+			break
+		}
+	}
+
+	if w.Player.IsAlive() && w.Alien.IsAlive() && entity.CollisionDetected(w.Alien, w.Player, w.ScreenBounds()) {
+		w.Player.Kill()
+	}
+
+	if w.Alien.IsAlive() {
+		for _, idx := range asteroidIdxs {
+			asteroid := w.Asteroids[idx]
+			if !asteroid.IsExploded() && entity.CollisionDetected(asteroid, w.Alien, w.ScreenBounds()) {
+				w.smash(asteroid)
+				w.Alien.Kill()
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkHandleCollisionDetection(b *testing.B) {
+	benchmarks := []struct {
+		name   string
+		detect func(w *World)
+	}{
+		{"spatial-hash", (*World).HandleCollisionDetection},
+		{"all-pairs", handleCollisionDetectionAllPairs},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			w := denseWorld(b)
+			for b.Loop() {
+				bm.detect(w)
+			}
+		})
+	}
+}
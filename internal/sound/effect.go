@@ -0,0 +1,32 @@
+package sound
+
+type Effect int
+
+const (
+	PlayerFire Effect = iota
+	AlienFire
+	Thrust
+	PlayerExplosion
+	AlienExplosion
+	AsteroidExplosion
+	ExtraLife
+	GameOver
+	BeatLow
+	BeatHigh
+)
+
+// Sink receives the sound effects triggered by the simulation. The game
+// front-end plays them out loud, whereas headless runs can simply mute them.
+// Loop keeps an effect repeating until it is stopped; calling it again
+// while it is already going carries on as before.
+type Sink interface {
+	Play(effect Effect)
+	Loop(effect Effect)
+	Stop(effect Effect)
+}
+
+type Mute struct{}
+
+func (Mute) Play(effect Effect) {}
+func (Mute) Loop(effect Effect) {}
+func (Mute) Stop(effect Effect) {}
@@ -1,73 +1,115 @@
 package sprites
 
 import (
-	"bytes"
+	"errors"
+	"fmt"
 	"image"
-	_ "image/png"
 	"math/rand"
 
 	"github.com/rm-hull/asteroids/internal/geometry"
 	"github.com/rm-hull/asteroids/resources/images"
+)
+
+// Frame is a region of the sprite sheet. Only the geometry lives here, so
+// the simulation can size things up without needing a graphics context; the
+// renderer cuts the actual images out of the sheet. Rect is in pixels of the
+// sheet, which are Scale times bigger on screen; everything else is already
+// scaled to the screen, with Pivot and Hull relative to the top left of Rect.
+// Reach is how far the hull extends from the Pivot, whichever way the frame
+// is turned, or just the Radius if it doesn't have a hull.
+type Frame struct {
+	Name   string
+	Rect   image.Rectangle
+	Scale  float64
+	Pivot  geometry.Vector
+	Radius float64
+	Hull   []geometry.Vector
+	Reach  float64
+}
 
-	"github.com/hajimehoshi/ebiten/v2"
+const DefaultAtlas = "asteroids-2x.json"
+
+// How big the small saucer is next to the large one, for atlases without a
+// "small-alien" frame of their own.
+const smallAlienScale = 0.6
+
+// The frames the game is drawn with, as named in the current atlas.
+var (
+	LargeAsteroids  []*Frame
+	MediumAsteroids []*Frame
+	SmallAsteroids  []*Frame
+	AlienSpaceShip  *Frame
+	SmallAlienShip  *Frame
+	SpaceShip1      *Frame
+	SpaceShip2      *Frame
+	Bullet1         *Frame
+	Bullet2         *Frame
 )
 
-var spriteSheet = mustLoadImage(images.Asteroids2X_png)
+var current *Atlas
 
-func mustLoadImage(b []byte) *ebiten.Image {
-	r := bytes.NewReader(b)
-	img, _, err := image.Decode(r)
+func init() {
+	atlas, err := LoadAtlas(images.FS, DefaultAtlas)
 	if err != nil {
 		panic(err)
 	}
-
-	return ebiten.NewImageFromImage(img)
+	if err := Use(atlas); err != nil {
+		panic(err)
+	}
 }
 
-func sprite(spriteSheet *ebiten.Image, x, y, w, h int) *ebiten.Image {
-	img := spriteSheet.SubImage(image.Rectangle{
-		image.Point{x, y},
-		image.Point{x + w, y + h},
-	})
+// Use switches to drawing with the frames from atlas, which must have all
+// of the frames the game needs; only "small-alien" is optional.
+func Use(atlas *Atlas) error {
+	var missing []error
+	frame := func(name string) *Frame {
+		f, ok := atlas.Frames[name]
+		if !ok {
+			missing = append(missing, fmt.Errorf("no %q frame", name))
+		}
+		return f
+	}
+	frames := func(name string) []*Frame {
+		return []*Frame{frame(name + "-1"), frame(name + "-2"), frame(name + "-3")}
+	}
 
-	return ebiten.NewImageFromImage(img)
-}
+	largeAsteroids := frames("large-asteroid")
+	mediumAsteroids := frames("medium-asteroid")
+	smallAsteroids := frames("small-asteroid")
+	alienSpaceShip := frame("alien")
+	spaceShip1 := frame("ship")
+	spaceShip2 := frame("ship-thrust")
+	bullet1 := frame("small-bullet")
+	bullet2 := frame("large-bullet")
+	if len(missing) > 0 {
+		return errors.Join(missing...)
+	}
 
-// 2x
-var LargeAsteroids = []*ebiten.Image{
-	sprite(spriteSheet, 0, 0, 160, 160),
-	sprite(spriteSheet, 160, 0, 160, 160),
-	sprite(spriteSheet, 320, 0, 160, 160),
-}
+	smallAlienShip, ok := atlas.Frames["small-alien"]
+	if !ok {
+		smallAlienShip = alienSpaceShip.scaled("small-alien", smallAlienScale)
+	}
 
-var MediumAsteroids = []*ebiten.Image{
-	sprite(spriteSheet, 0, 160, 80, 96),
-	sprite(spriteSheet, 96, 160, 80, 96),
-	sprite(spriteSheet, 192, 160, 80, 96),
+	LargeAsteroids, MediumAsteroids, SmallAsteroids = largeAsteroids, mediumAsteroids, smallAsteroids
+	AlienSpaceShip, SmallAlienShip = alienSpaceShip, smallAlienShip
+	SpaceShip1, SpaceShip2 = spaceShip1, spaceShip2
+	Bullet1, Bullet2 = bullet1, bullet2
+	current = atlas
+	return nil
 }
 
-var SmallAsteroids = []*ebiten.Image{
-	sprite(spriteSheet, 0, 254, 64, 64),
-	sprite(spriteSheet, 64, 254, 64, 64),
-	sprite(spriteSheet, 128, 254, 64, 64),
+func Current() *Atlas {
+	return current
 }
 
-var AlienSpaceShip = sprite(spriteSheet, 416, 160, 96, 80)
-
-var SpaceShip1 = sprite(spriteSheet, 192, 254, 96, 64)
-var SpaceShip2 = sprite(spriteSheet, 288, 254, 96, 64)
-
-var Bullet1 = sprite(spriteSheet, 448, 286, 32, 32)
-var Bullet2 = sprite(spriteSheet, 480, 286, 32, 32)
-
 const (
 	Large = iota
 	Medium
 	Small
 )
 
-func Asteroid(size int) *ebiten.Image {
-	idx := rand.Intn(3)
+func Asteroid(size int, rng *rand.Rand) *Frame {
+	idx := rng.Intn(3)
 	switch size {
 	case Large:
 		return LargeAsteroids[idx]
@@ -78,7 +120,16 @@ func Asteroid(size int) *ebiten.Image {
 	}
 }
 
-func Bullet(size int) *ebiten.Image {
+func Alien(size int) *Frame {
+	switch size {
+	case Small:
+		return SmallAlienShip
+	default:
+		return AlienSpaceShip
+	}
+}
+
+func Bullet(size int) *Frame {
 	switch size {
 	case Large:
 		return Bullet2
@@ -87,18 +138,14 @@ func Bullet(size int) *ebiten.Image {
 	}
 }
 
-func Centre(sprite *ebiten.Image) geometry.Vector {
-	bounds := sprite.Bounds()
-	return geometry.Vector{
-		X: float64(bounds.Dx()) / 2,
-		Y: float64(bounds.Dy()) / 2,
-	}
+// Centre is the point the frame rotates about.
+func Centre(frame *Frame) geometry.Vector {
+	return frame.Pivot
 }
 
-func Size(sprite *ebiten.Image) *geometry.Dimension {
-	bounds := sprite.Bounds()
+func Size(frame *Frame) *geometry.Dimension {
 	return &geometry.Dimension{
-		W: float64(bounds.Dx()),
-		H: float64(bounds.Dy()),
+		W: float64(frame.Rect.Dx()) * frame.Scale,
+		H: float64(frame.Rect.Dy()) * frame.Scale,
 	}
 }
@@ -0,0 +1,128 @@
+package sprites
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/png"
+	"io/fs"
+	"path"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+// Atlas is a sprite sheet along with the named frames on it.
+type Atlas struct {
+	Sheet  image.Image
+	Frames map[string]*Frame
+}
+
+// atlasFile is the JSON descriptor that sits next to a sprite sheet. The
+// pivot is what the frame rotates about, and defaults to its middle; the
+// radius is how close something has to get to collide with it, and
+// defaults to half its width or height, whichever is smaller. Frames marked
+// with hull also collide by the outline of their opaque pixels.
+//
+// All of these are in pixels of the sheet, which are drawn scale times
+// bigger on screen (by default, the same size), so that a low resolution
+// sheet plays the same as a high resolution one. A frame can also have a
+// scale of its own, to reuse part of the sheet at a different size.
+type atlasFile struct {
+	Image  string  `json:"image"`
+	Scale  float64 `json:"scale"`
+	Frames map[string]struct {
+		X      int              `json:"x"`
+		Y      int              `json:"y"`
+		W      int              `json:"w"`
+		H      int              `json:"h"`
+		Radius float64          `json:"radius"`
+		Pivot  *geometry.Vector `json:"pivot"`
+		Hull   bool             `json:"hull"`
+		Scale  float64          `json:"scale"`
+	} `json:"frames"`
+}
+
+// LoadAtlas reads the named descriptor, and the sheet it refers to, from
+// the same directory of fsys.
+func LoadAtlas(fsys fs.FS, name string) (*Atlas, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var file atlasFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	sheetFile, err := fsys.Open(path.Join(path.Dir(name), file.Image))
+	if err != nil {
+		return nil, err
+	}
+	defer sheetFile.Close()
+
+	sheet, _, err := image.Decode(sheetFile)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file.Image, err)
+	}
+
+	atlas := &Atlas{
+		Sheet:  sheet,
+		Frames: make(map[string]*Frame, len(file.Frames)),
+	}
+	scale := file.Scale
+	if scale == 0 {
+		scale = 1
+	}
+
+	for frameName, f := range file.Frames {
+		scale := scale
+		if f.Scale != 0 {
+			scale *= f.Scale
+		}
+
+		frame := &Frame{
+			Name:   frameName,
+			Rect:   image.Rect(f.X, f.Y, f.X+f.W, f.Y+f.H),
+			Scale:  scale,
+			Pivot:  geometry.Vector{X: float64(f.W) / 2, Y: float64(f.H) / 2},
+			Radius: f.Radius,
+		}
+		if f.Pivot != nil {
+			frame.Pivot = *f.Pivot
+		}
+		if frame.Radius == 0 {
+			frame.Radius = float64(min(f.W, f.H)) / 2
+		}
+		if f.Hull {
+			frame.Hull = traceHull(sheet, frame.Rect)
+		}
+
+		frame.Pivot.Scale(scale)
+		frame.Radius *= scale
+		for i := range frame.Hull {
+			frame.Hull[i].Scale(scale)
+		}
+		frame.Reach = reach(frame)
+		atlas.Frames[frameName] = frame
+	}
+	return atlas, nil
+}
+
+// scaled is a copy of the frame, drawn factor times the size.
+func (f *Frame) scaled(name string, factor float64) *Frame {
+	scaled := &Frame{
+		Name:   name,
+		Rect:   f.Rect,
+		Scale:  f.Scale * factor,
+		Pivot:  f.Pivot,
+		Radius: f.Radius * factor,
+		Reach:  f.Reach * factor,
+	}
+	scaled.Pivot.Scale(factor)
+	for _, v := range f.Hull {
+		v.Scale(factor)
+		scaled.Hull = append(scaled.Hull, v)
+	}
+	return scaled
+}
@@ -0,0 +1,70 @@
+package sprites
+
+import (
+	"image"
+	"math"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+const (
+	// Only the solid part of a sprite counts, not its soft glow.
+	hullAlphaThreshold = 0x80
+	maxHullVertices    = 12
+)
+
+// traceHull finds the convex outline of the opaque pixels within rect, in
+// co-ordinates relative to the top left of rect.
+func traceHull(sheet image.Image, rect image.Rectangle) []geometry.Vector {
+	var points []geometry.Vector
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		left, right := -1, -1
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			if _, _, _, a := sheet.At(x, y).RGBA(); a>>8 >= hullAlphaThreshold {
+				if left < 0 {
+					left = x
+				}
+				right = x
+			}
+		}
+		if left < 0 {
+			continue
+		}
+
+		top := float64(y - rect.Min.Y)
+		points = append(points,
+			geometry.Vector{X: float64(left - rect.Min.X), Y: top},
+			geometry.Vector{X: float64(left - rect.Min.X), Y: top + 1},
+			geometry.Vector{X: float64(right - rect.Min.X + 1), Y: top},
+			geometry.Vector{X: float64(right - rect.Min.X + 1), Y: top + 1},
+		)
+	}
+
+	if len(points) == 0 {
+		return nil
+	}
+	return geometry.Simplify(geometry.ConvexHull(points), maxHullVertices)
+}
+
+func reach(frame *Frame) float64 {
+	if frame.Hull == nil {
+		return frame.Radius
+	}
+	return geometry.Radius(geometry.Translate(frame.Hull, geometry.Vector{X: -frame.Pivot.X, Y: -frame.Pivot.Y}))
+}
+
+// Hull is the outline of the current frame, rotated to the sprite's
+// orientation, relative to its centre; nil if the frame doesn't have one.
+func (s *Sprite) Hull() []geometry.Vector {
+	if s.Frame.Hull == nil {
+		return nil
+	}
+
+	sin, cos := math.Sincos(s.Orientation)
+	hull := make([]geometry.Vector, len(s.Frame.Hull))
+	for i, v := range s.Frame.Hull {
+		x, y := v.X-s.Centre.X, v.Y-s.Centre.Y
+		hull[i] = geometry.Vector{X: x*cos - y*sin, Y: x*sin + y*cos}
+	}
+	return hull
+}
@@ -0,0 +1,66 @@
+package sprites
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/rm-hull/asteroids/internal/geometry"
+)
+
+// sheet is a small image with an opaque 4x4 block at (2, 2), and a faint
+// pixel of glow beside it that shouldn't count.
+func sheet() image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	for y := 2; y < 6; y++ {
+		for x := 2; x < 6; x++ {
+			img.Set(x, y, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff})
+		}
+	}
+	img.Set(8, 8, color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x40})
+	return img
+}
+
+func TestTraceHull(t *testing.T) {
+	tests := []struct {
+		name string
+		rect image.Rectangle
+		want []geometry.Vector
+	}{
+		{"whole sheet", image.Rect(0, 0, 10, 10), []geometry.Vector{{X: 2, Y: 2}, {X: 6, Y: 2}, {X: 6, Y: 6}, {X: 2, Y: 6}}},
+		{"relative to the frame", image.Rect(1, 1, 10, 10), []geometry.Vector{{X: 1, Y: 1}, {X: 5, Y: 1}, {X: 5, Y: 5}, {X: 1, Y: 5}}},
+		{"nothing opaque", image.Rect(6, 6, 10, 10), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := traceHull(sheet(), test.rect); !slices.Equal(got, test.want) {
+				t.Errorf("traceHull = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestReach(t *testing.T) {
+	hull := traceHull(sheet(), image.Rect(0, 0, 10, 10))
+
+	tests := []struct {
+		name  string
+		frame Frame
+		want  float64
+	}{
+		{"no hull", Frame{Pivot: geometry.Vector{X: 4, Y: 4}, Radius: 3}, 3},
+		{"pivot in the middle of the hull", Frame{Pivot: geometry.Vector{X: 4, Y: 4}, Radius: 1, Hull: hull}, 2 * math.Sqrt2},
+		{"pivot off to one side", Frame{Pivot: geometry.Vector{X: 2, Y: 4}, Radius: 1, Hull: hull}, math.Hypot(4, 2)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := reach(&test.frame); math.Abs(got-test.want) > 1e-9 {
+				t.Errorf("reach = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -1,14 +1,14 @@
 package sprites
 
 import (
-	"github.com/rm-hull/asteroids/internal/geometry"
+	"math"
 
-	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/colorm"
+	"github.com/rm-hull/asteroids/internal/geometry"
 )
 
 type Sprite struct {
 	Position     *geometry.Vector
+	previous     previousState
 	Velocity     *geometry.Vector
 	Orientation  float64
 	Direction    float64
@@ -16,19 +16,21 @@ type Sprite struct {
 	Rotation     float64
 	Centre       *geometry.Vector
 	Size         *geometry.Dimension
-	Image        *ebiten.Image
+	Frame        *Frame
 	screenBounds *geometry.Dimension
 	wraparound   bool
-	ColorModel   colorm.ColorM
-	DrawOptions  *colorm.DrawImageOptions
 }
 
-func NewSprite(screenBounds *geometry.Dimension, image *ebiten.Image, wraparound bool) *Sprite {
-	bounds := image.Bounds()
-	centre := geometry.Vector{
-		X: float64(bounds.Dx()) / 2,
-		Y: float64(bounds.Dy()) / 2,
-	}
+// previousState is where the sprite was at the end of the last tick, so that
+// drawing can interpolate smoothly between ticks.
+type previousState struct {
+	position    geometry.Vector
+	orientation float64
+	valid       bool
+}
+
+func NewSprite(screenBounds *geometry.Dimension, frame *Frame, wraparound bool) *Sprite {
+	centre := Centre(frame)
 
 	return &Sprite{
 		Position:     geometry.Zero(),
@@ -38,16 +40,17 @@ func NewSprite(screenBounds *geometry.Dimension, image *ebiten.Image, wraparound
 		Speed:        0,
 		Rotation:     0,
 		Centre:       &centre,
-		Size:         Size(image),
-		Image:        image,
+		Size:         Size(frame),
+		Frame:        frame,
 		screenBounds: screenBounds,
 		wraparound:   wraparound,
-		ColorModel:   colorm.ColorM{},
-		DrawOptions:  &colorm.DrawImageOptions{},
 	}
 }
 
+// Reset stops the sprite, ready to be put somewhere new: where it was before
+// is forgotten, so it isn't drawn sliding across from there.
 func (s *Sprite) Reset() {
+	s.previous = previousState{}
 	s.Orientation = 0
 	s.Rotation = 0
 	s.Direction = 0
@@ -57,6 +60,12 @@ func (s *Sprite) Reset() {
 }
 
 func (s *Sprite) Update() error {
+	s.previous = previousState{
+		position:    *s.Position,
+		orientation: s.Orientation,
+		valid:       true,
+	}
+
 	s.Orientation += s.Rotation
 	s.Position.Add(s.Velocity)
 	if s.wraparound {
@@ -65,36 +74,32 @@ func (s *Sprite) Update() error {
 	return nil
 }
 
-func (s *Sprite) Draw(screen *ebiten.Image) {
-
-	s.DrawOptions.GeoM.Translate(-s.Centre.X, -s.Centre.Y)
-	s.DrawOptions.GeoM.Rotate(s.Orientation)
-	s.DrawOptions.GeoM.Translate(s.Centre.X, s.Centre.Y)
-
-	// vector.DrawFilledRect(screen,
-	// 	float32(s.Position.X), float32(s.Position.Y),
-	// 	float32(s.Size.W), float32(s.Size.H),
-	// 	color.RGBA{255, 128, 0, 255}, false)
-
-	s.DrawOptions.GeoM.Translate(s.Position.X, s.Position.Y)
-	colorm.DrawImage(screen, s.Image, s.ColorModel, s.DrawOptions)
-
-	if s.wraparound {
-		s.DrawOptions.GeoM.Translate(s.screenBounds.W, 0)
-		colorm.DrawImage(screen, s.Image, s.ColorModel, s.DrawOptions)
-
-		s.DrawOptions.GeoM.Translate(-s.screenBounds.W, +s.screenBounds.H)
-		colorm.DrawImage(screen, s.Image, s.ColorModel, s.DrawOptions)
+// Wraparound sprites are drawn on both sides of any screen edge they
+// happen to be straddling.
+func (s *Sprite) Wraparound() bool {
+	return s.wraparound
+}
 
-		s.DrawOptions.GeoM.Translate(-s.screenBounds.W, -s.screenBounds.H)
-		colorm.DrawImage(screen, s.Image, s.ColorModel, s.DrawOptions)
+// Interpolate returns the position and orientation blend (0..1) of the way
+// from the previous tick to the current one. Jumps of more than half the
+// screen, such as wrapping around an edge, are not smoothed over.
+func (s *Sprite) Interpolate(blend float64) (geometry.Vector, float64) {
+	if !s.previous.valid {
+		return *s.Position, s.Orientation
+	}
 
-		s.DrawOptions.GeoM.Translate(+s.screenBounds.W, -s.screenBounds.H)
-		colorm.DrawImage(screen, s.Image, s.ColorModel, s.DrawOptions)
+	dx := s.Position.X - s.previous.position.X
+	dy := s.Position.Y - s.previous.position.Y
+	if math.Abs(dx) > s.screenBounds.W/2 || math.Abs(dy) > s.screenBounds.H/2 {
+		return *s.Position, s.Orientation
 	}
 
-	s.ColorModel.Reset()
-	s.DrawOptions.GeoM.Reset()
+	position := geometry.Vector{
+		X: s.previous.position.X + dx*blend,
+		Y: s.previous.position.Y + dy*blend,
+	}
+	orientation := s.previous.orientation + (s.Orientation-s.previous.orientation)*blend
+	return position, orientation
 }
 
 func (s *Sprite) checkEdges() {
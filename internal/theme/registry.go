@@ -0,0 +1,106 @@
+package theme
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/rm-hull/asteroids/internal/sprites"
+	"github.com/rm-hull/asteroids/resources/images"
+)
+
+const (
+	Default = "hd"
+
+	// Each user theme is a directory holding an atlas by this name, along
+	// with the sheet it refers to.
+	atlasName = "atlas.json"
+)
+
+// Theme is a sprite sheet which the game can be drawn with.
+type Theme struct {
+	Name  string
+	fsys  fs.FS
+	atlas string
+}
+
+// Registry knows all the themes there are to pick from: the sheets built
+// into the game, plus any found in the user's themes directory.
+type Registry struct {
+	themes []Theme
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		themes: []Theme{
+			{Name: "hd", fsys: images.FS, atlas: sprites.DefaultAtlas},
+			{Name: "classic", fsys: images.FS, atlas: "asteroids.json"},
+		},
+	}
+}
+
+// AddDir adds a theme for each sub-directory of dir with an atlas in it,
+// named after the sub-directory. A theme with the same name as one already
+// registered replaces it. It is not an error for dir not to exist.
+func (r *Registry) AddDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(path, atlasName)); err != nil {
+			continue
+		}
+
+		theme := Theme{Name: entry.Name(), fsys: os.DirFS(path), atlas: atlasName}
+		if idx := r.indexOf(theme.Name); idx >= 0 {
+			r.themes[idx] = theme
+		} else {
+			r.themes = append(r.themes, theme)
+		}
+	}
+	return nil
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, len(r.themes))
+	for i, theme := range r.themes {
+		names[i] = theme.Name
+	}
+	return names
+}
+
+// Use loads the named theme and draws the game with it from then on.
+func (r *Registry) Use(name string) error {
+	idx := r.indexOf(name)
+	if idx < 0 {
+		return fmt.Errorf("unknown theme %q, expected one of %v", name, r.Names())
+	}
+
+	theme := r.themes[idx]
+	atlas, err := sprites.LoadAtlas(theme.fsys, theme.atlas)
+	if err != nil {
+		return fmt.Errorf("theme %q: %w", name, err)
+	}
+	if err := sprites.Use(atlas); err != nil {
+		return fmt.Errorf("theme %q: %w", name, err)
+	}
+	return nil
+}
+
+func (r *Registry) indexOf(name string) int {
+	return slices.IndexFunc(r.themes, func(theme Theme) bool {
+		return theme.Name == name
+	})
+}
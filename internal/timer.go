@@ -2,10 +2,16 @@ package internal
 
 import (
 	"time"
-
-	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// The simulation advances in fixed ticks, independent of the display or
+// however fast the host machine happens to be.
+const TicksPerSecond = 60
+
+func Ticks(d time.Duration) int {
+	return int(d.Milliseconds()) * TicksPerSecond / 1000
+}
+
 type Timer struct {
 	currentTicks int
 	targetTicks  int
@@ -14,7 +20,7 @@ type Timer struct {
 func NewTimer(d time.Duration) *Timer {
 	return &Timer{
 		currentTicks: 0,
-		targetTicks:  int(d.Milliseconds()) * ebiten.TPS() / 1000,
+		targetTicks:  Ticks(d),
 	}
 }
 
@@ -34,7 +40,7 @@ func (t *Timer) Reset() {
 
 func (t *Timer) ResetTarget(d time.Duration) {
 	t.currentTicks = 0
-	t.targetTicks = int(d.Milliseconds()) * ebiten.TPS() / 1000
+	t.targetTicks = Ticks(d)
 }
 
 func (t *Timer) CurrentTicks() int {
@@ -0,0 +1,23 @@
+package internal
+
+import "runtime/debug"
+
+// Version identifies the build: the module version when installed with
+// `go install ...@version`, otherwise the VCS revision it was built from.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	if version := info.Main.Version; version != "" && version != "(devel)" {
+		return version
+	}
+
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return "devel"
+}
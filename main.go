@@ -2,164 +2,329 @@ package main
 
 import (
 	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/rm-hull/asteroids/internal"
 	"github.com/rm-hull/asteroids/internal/entity"
 	"github.com/rm-hull/asteroids/internal/geometry"
+	"github.com/rm-hull/asteroids/internal/highscore"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/input/device"
+	"github.com/rm-hull/asteroids/internal/render"
+	"github.com/rm-hull/asteroids/internal/replay"
+	"github.com/rm-hull/asteroids/internal/scene"
+	"github.com/rm-hull/asteroids/internal/score"
+	"github.com/rm-hull/asteroids/internal/sfx"
+	"github.com/rm-hull/asteroids/internal/sim"
+	"github.com/rm-hull/asteroids/internal/theme"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 type Game struct {
-	Player     *entity.Player
-	Alien      *entity.Alien
-	Asteroids  map[int]*entity.Asteroid
-	Sequence   *internal.Sequence
-	Level      *entity.Level
+	World      *sim.World
+	Scenes     *scene.Manager
+	Renderer   *render.Renderer
+	Controls   *input.Controller
+	Touch      *device.TouchControls
+	Sounds     *sfx.Manager
+	HighScores *highscore.Table
+	seeds      func() int64
+	scoreStore highscore.Store
+	recording  *replay.Replay
+	replayPath string
+	theme      string
 	fullscreen bool
-	paused     bool
+	clock      *internal.Clock
+	lastUpdate time.Time
+	moving     bool
 }
 
 var screenSize = geometry.Dimension{W: 1024, H: 768}
 
+// Update runs as many fixed-length simulation ticks as have elapsed since it
+// was last called, so the game plays at the same speed whatever rate ebiten
+// is calling it at.
 func (g *Game) Update() error {
+	g.Controls.Poll()
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyQ) {
+	for range g.clock.Advance(g.elapsed()) {
+		if err := g.tick(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Game) tick() error {
+	g.Controls.Update()
+
+	if g.Controls.IsJustPressed(input.Quit) {
 		return errors.New("dejar de ser un desertor")
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyF) {
+	if g.Controls.IsJustPressed(input.Fullscreen) {
 		g.fullscreen = !g.fullscreen
 		ebiten.SetFullscreen(g.fullscreen)
 	}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
-		g.Reset(6)
+	frames, ticks := g.World.Frames(), g.World.Ticks
+	err := g.Scenes.Update()
+	g.moving = g.World.Frames() != frames
+
+	// Looping sounds, like the ship's engine, only carry on while the game
+	// is actually being played.
+	if g.World.Ticks == ticks {
+		g.Sounds.Pause()
+	} else {
+		g.Sounds.Resume()
 	}
+	g.Sounds.Update()
+	return err
+}
 
-	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
-		g.paused = !g.paused
+// elapsed is the time since the last update: exactly one update's worth
+// when ebiten runs at a fixed TPS, otherwise measured on the wall clock.
+func (g *Game) elapsed() time.Duration {
+	now := time.Now()
+	last := g.lastUpdate
+	g.lastUpdate = now
+
+	if tps := ebiten.TPS(); tps > 0 {
+		return time.Second / time.Duration(tps)
+	}
+	if last.IsZero() {
+		return 0
 	}
+	return now.Sub(last)
+}
 
-	if g.paused {
-		return nil
+// Blend is how far between the last two ticks to draw the world. Whilst the
+// world is stood still (e.g. paused) it is drawn exactly where it is.
+func (g *Game) Blend() float64 {
+	if !g.moving {
+		return 1.0
 	}
+	return g.clock.Blend()
+}
 
-	for idx, asteroid := range g.Asteroids {
-		err := asteroid.Update()
-		if err != nil {
-			return err
-		}
+func (g *Game) Draw(screen *ebiten.Image) {
+	g.Scenes.Draw(screen)
+	g.Touch.Draw(screen)
+}
 
-		if asteroid.IsExploded() {
-			g.Player.UpdateScore(asteroid.Value())
-			delete(g.Asteroids, idx)
-		}
-	}
+func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
+	return int(screenSize.W), int(screenSize.H)
+}
 
-	err := g.Player.Update()
-	if err != nil {
-		return err
+// Reset starts a new game, first saving the replay of any game in progress,
+// so that restarting part way through doesn't throw it away.
+func (g *Game) Reset() {
+	if g.recording != nil && g.recording.Ticks > 0 {
+		g.SaveReplay()
 	}
 
-	g.HandleCollisionDetection()
+	seed := g.seeds()
+	log.Printf("starting new game with seed: %d", seed)
+	g.World.Reset(seed)
+	g.recording = replay.New(internal.Version(), g.theme, seed)
+}
 
-	err = g.Alien.Update()
-	if err != nil {
-		return err
+// Step advances the game by one tick, recording the controls as it goes.
+func (g *Game) Step() error {
+	actions := g.Controls.Current()
+	g.recording.Record(actions)
+	return g.World.Step(actions)
+}
+
+func (g *Game) SaveReplay() {
+	if g.replayPath == "" || g.recording == nil {
+		return
 	}
 
-	err = g.Level.Update()
-	if err != nil {
-		return err
+	if err := os.MkdirAll(filepath.Dir(g.replayPath), 0o755); err != nil {
+		log.Printf("unable to save replay: %v", err)
+		return
 	}
 
-	if len(g.Asteroids) == 0 {
-		g.NextLevel()
+	file, err := os.Create(g.replayPath)
+	if err != nil {
+		log.Printf("unable to save replay: %v", err)
+		return
 	}
+	defer file.Close()
 
-	return nil
+	if _, err := g.recording.WriteTo(file); err != nil {
+		log.Printf("unable to save replay: %v", err)
+	}
 }
 
-func (g *Game) HandleCollisionDetection() {
-	g.Player.Bullets(func(bullet *entity.Bullet) {
-		for _, asteroid := range g.Asteroids {
-			if !asteroid.IsExploded() && bullet.CollisionDetected(asteroid) {
-				for _, fragment := range asteroid.Explode() {
-					g.Asteroids[g.Sequence.GetNext()] = fragment
-				}
-				return
-			}
-		}
+func loadReplay(path, theme string) (*replay.Replay, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
 
-		if g.Alien.IsAlive() && bullet.CollisionDetected(g.Alien) {
-			g.Alien.Kill()
-			g.Player.UpdateScore(g.Alien.Value())
-		}
-	})
+	return replay.Read(file, internal.Version(), theme)
+}
 
-	g.Alien.Bullets(func(bullet *entity.Bullet) {
-		if g.Player.IsAlive() && bullet.CollisionDetected(g.Player) {
-			g.Player.Kill()
-		}
+func (g *Game) RecordHighScore(initials string, score int, level int) {
+	g.HighScores.Add(highscore.Entry{
+		Initials: initials,
+		Score:    score,
+		Level:    level,
+		Date:     time.Now(),
 	})
 
-	for _, asteroid := range g.Asteroids {
-		if g.Player.IsAlive() && entity.CollisionDetected(asteroid, g.Player) {
-			g.Player.Kill()
-			break
-		}
+	if g.scoreStore == nil {
+		return
 	}
-
-	if g.Player.IsAlive() && g.Alien.IsAlive() && entity.CollisionDetected(g.Alien, g.Player) {
-		g.Player.Kill()
+	if err := g.scoreStore.Save(g.HighScores); err != nil {
+		log.Printf("unable to save high scores: %v", err)
 	}
-
 }
-func (g *Game) Draw(screen *ebiten.Image) {
-	for _, asteroid := range g.Asteroids {
-		asteroid.Draw(screen)
-	}
 
-	g.Player.Draw(screen)
-	g.Alien.Draw(screen)
-	g.Level.Draw(screen)
-}
+func loadHighScores() (*highscore.Table, highscore.Store) {
+	store, err := highscore.NewStore(highscore.DefaultSize)
+	if err != nil {
+		log.Printf("high scores will not be saved: %v", err)
+		return highscore.NewTable(highscore.DefaultSize), nil
+	}
 
-func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
-	return int(screenSize.W), int(screenSize.H)
+	table, err := store.Load()
+	if err != nil {
+		log.Printf("unable to load high scores: %v", err)
+		return highscore.NewTable(highscore.DefaultSize), store
+	}
+	return table, store
 }
 
-func (g *Game) Reset(n int) {
-	g.Level.Reset(1)
-	g.Player = entity.NewPlayer(&screenSize)
-	g.Alien = entity.NewAlien(1, g.Player.NotNear(), g.Player.Position, &screenSize)
-	g.Asteroids = entity.NewAsteroidBelt(n, g.Sequence, g.Player, &screenSize)
+// seedSource picks the seed for each new game: a fixed seed replays the
+// same game every time, the daily seed is shared by everyone playing on the
+// same (UTC) day, and otherwise every game is different.
+func seedSource(seed int64, daily bool) func() int64 {
+	switch {
+	case seed != 0:
+		return func() int64 { return seed }
+	case daily:
+		return func() int64 {
+			seed, _ := strconv.ParseInt(time.Now().UTC().Format("20060102"), 10, 64)
+			return seed
+		}
+	default:
+		return func() int64 { return time.Now().UnixNano() }
+	}
 }
 
-func (g *Game) NextLevel() {
-	g.Level.Next()
-	g.Player.Prepare()
-	g.Alien = entity.NewAlien(g.Level.Current(), g.Player.NotNear(), g.Player.Position, &screenSize)
-	g.Asteroids = entity.NewAsteroidBelt(5+g.Level.Current(), g.Sequence, g.Player, &screenSize)
+func defaultConfigPath(name string) string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "asteroids", name)
 }
 
 func main() {
-	player := entity.NewPlayer(&screenSize)
-	seq := internal.NewSequence()
+	controlsPath := flag.String("controls", defaultConfigPath("controls.json"), "path to a JSON file of key bindings")
+	recordPath := flag.String("record", defaultConfigPath("last.replay"), "where to save a replay of each game when it ends")
+	replayPath := flag.String("replay", "", "watch a previously recorded replay")
+	seed := flag.Int64("seed", 0, "random seed, to replay the same game each time (0 = random)")
+	daily := flag.Bool("daily", false, "play today's daily challenge")
+	style := flag.String("style", render.DefaultConfig.Style, fmt.Sprintf("how to draw the game, one of %v", render.Styles))
+	glow := flag.Float64("glow", render.DefaultConfig.Glow, "how much the lines glow in the vector style (0..1)")
+	persistence := flag.Float64("persistence", render.DefaultConfig.Persistence, "how much each frame lingers on the next in the vector style (0..1)")
+	themeName := flag.String("theme", theme.Default, "sprite sheet to draw the game with: a built-in one, or one in the themes directory")
+	sfxVolume := flag.Float64("sfx-volume", 1.0, "volume of the sound effects (0..1)")
+	musicVolume := flag.Float64("music-volume", 1.0, "volume of the background music (0..1)")
+	uiVolume := flag.Float64("ui-volume", 1.0, "volume of the jingles, such as for an extra life (0..1)")
+	hyperspaceRisk := flag.Float64("hyperspace-risk", entity.DefaultHyperspaceConfig.SelfDestructChance, "chance (0..1) of the ship exploding as it comes out of hyperspace")
+	landAnywhere := flag.Bool("land-anywhere", entity.DefaultHyperspaceConfig.LandAnywhere, "let hyperspace land the ship anywhere, even right next to where it left")
+	rollover := flag.Int("rollover", score.DefaultConfig.Rollover, "the score shown wraps back to zero on reaching this (0 = never)")
+	leadingZeros := flag.Bool("leading-zeros", score.DefaultConfig.LeadingZeros, "pad the score with leading zeros, like the arcade, e.g. 00420")
+	tps := flag.Int("tps", internal.TicksPerSecond, "how many times a second to update (0 = once per displayed frame); gameplay speed is unaffected")
+	flag.Parse()
+
+	renderer, err := render.NewRenderer(render.Config{
+		Style:       *style,
+		Glow:        *glow,
+		Persistence: *persistence,
+	})
+	if err != nil {
+		log.Fatalf("unable to draw the game: %v", err)
+	}
+
+	themes := theme.NewRegistry()
+	if dir := defaultConfigPath("themes"); dir != "" {
+		if err := themes.AddDir(dir); err != nil {
+			log.Printf("unable to load themes: %v", err)
+		}
+	}
+	if err := themes.Use(*themeName); err != nil {
+		log.Fatalf("unable to use theme: %v", err)
+	}
+
+	bindings, err := device.LoadKeyBindings(*controlsPath)
+	if err != nil {
+		log.Printf("unable to load key bindings, using defaults: %v", err)
+	}
+
+	touch := device.NewTouchControls(&screenSize)
+	controls := input.NewController(
+		device.NewKeyboard(bindings),
+		device.NewGamepads(device.DefaultGamepadBindings()),
+		touch,
+	)
+	seeds := seedSource(*seed, *daily)
+	sounds := sfx.NewManager()
+	sounds.SetVolume(sfx.SFX, *sfxVolume)
+	sounds.SetVolume(sfx.Music, *musicVolume)
+	sounds.SetVolume(sfx.UI, *uiVolume)
+	highScores, scoreStore := loadHighScores()
+	rules := entity.DefaultRules
+	rules.Hyperspace.SelfDestructChance = *hyperspaceRisk
+	rules.Hyperspace.LandAnywhere = *landAnywhere
+	rules.Score.Rollover = *rollover
+	rules.Score.LeadingZeros = *leadingZeros
 	g := &Game{
-		Sequence:   seq,
-		Player:     player,
-		Alien:      entity.NewAlien(1, player.NotNear(), player.Position, &screenSize),
-		Asteroids:  entity.NewAsteroidBelt(6, seq, player, &screenSize),
-		Level:      entity.NewLevel(&screenSize),
+		World:      sim.NewWorld(&screenSize, sounds, rules, seeds()),
+		Renderer:   renderer,
+		Controls:   controls,
+		Touch:      touch,
+		Sounds:     sounds,
+		HighScores: highScores,
+		seeds:      seeds,
+		scoreStore: scoreStore,
+		replayPath: *recordPath,
+		theme:      *themeName,
 		fullscreen: false,
+		clock:      internal.NewClock(),
 	}
 
+	var initial scene.Scene = NewTitleScene(g)
+	if *replayPath != "" {
+		r, err := loadReplay(*replayPath, *themeName)
+		if err != nil {
+			log.Fatalf("unable to load replay: %v", err)
+		}
+		initial = NewReplayScene(g, r)
+	}
+	g.Scenes = scene.NewManager(initial)
+
 	// ebiten.SetFullscreen(true)
+	if *tps > 0 {
+		ebiten.SetTPS(*tps)
+	} else {
+		ebiten.SetTPS(ebiten.SyncWithFPS)
+	}
 	ebiten.SetWindowSize(int(screenSize.W), int(screenSize.H))
-	err := ebiten.RunGame(g)
+	err = ebiten.RunGame(g)
 	if err != nil {
 		panic(err)
 	}
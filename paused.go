@@ -0,0 +1,30 @@
+package main
+
+import (
+	"github.com/rm-hull/asteroids/internal/fonts"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/scene"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type PausedScene struct {
+	game   *Game
+	resume scene.Scene
+}
+
+func NewPausedScene(game *Game, resume scene.Scene) *PausedScene {
+	return &PausedScene{game: game, resume: resume}
+}
+
+func (s *PausedScene) Update() error {
+	if s.game.Controls.IsJustPressed(input.Pause) {
+		s.game.Scenes.SwitchTo(s.resume)
+	}
+	return nil
+}
+
+func (s *PausedScene) Draw(screen *ebiten.Image) {
+	s.resume.Draw(screen)
+	drawCentred(screen, "PAUSED", fonts.AsteroidsFace64, 0)
+}
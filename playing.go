@@ -0,0 +1,40 @@
+package main
+
+import (
+	"github.com/rm-hull/asteroids/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type PlayingScene struct {
+	game *Game
+}
+
+func NewPlayingScene(game *Game) *PlayingScene {
+	return &PlayingScene{game: game}
+}
+
+func (s *PlayingScene) Update() error {
+	if s.game.Controls.IsJustPressed(input.Restart) {
+		s.game.Reset()
+	}
+
+	if s.game.Controls.IsJustPressed(input.Pause) {
+		s.game.Scenes.SwitchTo(NewPausedScene(s.game, s))
+		return nil
+	}
+
+	if err := s.game.Step(); err != nil {
+		return err
+	}
+
+	if s.game.World.IsGameOver() {
+		s.game.Scenes.SwitchTo(NewGameOverScene(s.game))
+	}
+
+	return nil
+}
+
+func (s *PlayingScene) Draw(screen *ebiten.Image) {
+	s.game.Renderer.World(screen, s.game.World, s.game.Blend())
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/fonts"
+	"github.com/rm-hull/asteroids/internal/input"
+	"github.com/rm-hull/asteroids/internal/replay"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const maxReplaySpeed = 8
+
+// ReplayScene plays back a recorded game through the simulation. Pause
+// freezes it, rotating right/left speeds it up or slows it down, and firing
+// while paused steps forward a single frame.
+type ReplayScene struct {
+	game   *Game
+	replay *replay.Replay
+	cursor *replay.Cursor
+	speed  int
+	paused bool
+}
+
+func NewReplayScene(game *Game, r *replay.Replay) *ReplayScene {
+	return &ReplayScene{
+		game:   game,
+		replay: r,
+	}
+}
+
+func (s *ReplayScene) Enter() {
+	s.game.World.Reset(s.replay.Seed)
+	s.cursor = s.replay.Cursor()
+	s.speed = 1
+	s.paused = false
+}
+
+func (s *ReplayScene) Update() error {
+	controls := s.game.Controls
+
+	if s.isFinished() {
+		if controls.IsJustPressed(input.Start) {
+			s.game.Scenes.SwitchTo(NewTitleScene(s.game))
+		}
+		return s.game.World.Drift()
+	}
+
+	if controls.IsJustPressed(input.Pause) {
+		s.paused = !s.paused
+	}
+	if controls.IsJustPressed(input.RotateRight) && s.speed < maxReplaySpeed {
+		s.speed *= 2
+	}
+	if controls.IsJustPressed(input.RotateLeft) && s.speed > 1 {
+		s.speed /= 2
+	}
+
+	steps := s.speed
+	if s.paused {
+		steps = 0
+		if controls.IsJustPressed(input.Fire) {
+			steps = 1
+		}
+	}
+
+	for i := 0; i < steps; i++ {
+		actions, ok := s.cursor.Next()
+		if !ok {
+			break
+		}
+		if err := s.game.World.Step(actions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ReplayScene) isFinished() bool {
+	return s.cursor.IsFinished() || s.game.World.IsGameOver()
+}
+
+func (s *ReplayScene) Draw(screen *ebiten.Image) {
+	s.game.Renderer.World(screen, s.game.World, s.game.Blend())
+
+	status := fmt.Sprintf("REPLAY  %s / %s  X%d", formatTicks(s.cursor.Tick()), formatTicks(s.replay.Ticks), s.speed)
+	if s.paused {
+		status = fmt.Sprintf("REPLAY  %s / %s  PAUSED", formatTicks(s.cursor.Tick()), formatTicks(s.replay.Ticks))
+	}
+	drawCentred(screen, status, fonts.AsteroidsFace32, 320)
+
+	if s.isFinished() {
+		drawCentred(screen, "END OF REPLAY", fonts.AsteroidsFace64, 0)
+	}
+}
+
+func formatTicks(ticks int) string {
+	seconds := ticks / internal.TicksPerSecond
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
@@ -1,8 +1,11 @@
 package images
 
 import (
-	_ "embed"
+	"embed"
 )
 
-//go:embed asteroids-2x.png
-var Asteroids2X_png []byte
+// FS holds the built-in sprite sheets, each with a JSON atlas describing
+// the frames on it.
+//
+//go:embed asteroids.png asteroids.json asteroids-2x.png asteroids-2x.json
+var FS embed.FS
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/rm-hull/asteroids/internal/text_align"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+func drawCentred(screen *ebiten.Image, message string, face text.Face, offsetY float64) {
+	x, y := text_align.Center(&screenSize, message, face)
+	op := &text.DrawOptions{}
+	op.ColorScale.ScaleWithColor(color.White)
+	op.GeoM.Translate(float64(x), float64(y)+offsetY)
+	text.Draw(screen, message, face, op)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rm-hull/asteroids/internal"
+	"github.com/rm-hull/asteroids/internal/fonts"
+	"github.com/rm-hull/asteroids/internal/input"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const attractDuration = 6 * time.Second
+
+type TitleScene struct {
+	game         *Game
+	ticks        int
+	attractTimer *internal.Timer
+	showScores   bool
+}
+
+func NewTitleScene(game *Game) *TitleScene {
+	return &TitleScene{
+		game:         game,
+		attractTimer: internal.NewTimer(attractDuration),
+	}
+}
+
+func (s *TitleScene) Enter() {
+	s.ticks = 0
+	s.showScores = false
+	s.attractTimer.Reset()
+	s.game.World.Attract()
+}
+
+func (s *TitleScene) Update() error {
+	s.ticks++
+
+	if s.game.Controls.IsJustPressed(input.Start) {
+		s.game.Reset()
+		s.game.Scenes.SwitchTo(NewPlayingScene(s.game))
+		return nil
+	}
+
+	s.attractTimer.Update()
+	if s.attractTimer.IsReady() {
+		s.attractTimer.Reset()
+		s.showScores = !s.showScores && len(s.game.HighScores.Entries()) > 0
+	}
+
+	return s.game.World.Drift()
+}
+
+func (s *TitleScene) Draw(screen *ebiten.Image) {
+	s.game.Renderer.Asteroids(screen, s.game.World, s.game.Blend())
+
+	if s.showScores {
+		s.drawHighScores(screen)
+	} else {
+		drawCentred(screen, "ASTEROIDS", fonts.AsteroidsFace64, -96)
+	}
+
+	if (s.ticks/30)%2 == 0 {
+		drawCentred(screen, "PRESS START TO PLAY", fonts.AsteroidsFace32, 320)
+	}
+}
+
+func (s *TitleScene) drawHighScores(screen *ebiten.Image) {
+	drawCentred(screen, "HIGH SCORES", fonts.AsteroidsFace64, -288)
+
+	for i, entry := range s.game.HighScores.Entries() {
+		line := fmt.Sprintf("%2d. %-3s %7d  L%-2d %s", i+1, entry.Initials, entry.Score, entry.Level, entry.Date.Format("02 Jan 06"))
+		drawCentred(screen, line, fonts.AsteroidsFace32, float64(-192+i*44))
+	}
+}